@@ -4,18 +4,102 @@ import (
 	"fmt"
 	"go-task-cli/internal/app"
 	"go-task-cli/internal/config"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
 	"go-task-cli/internal/repository"
 	"go-task-cli/internal/service"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
+const lockTimeout = 5 * time.Second
+
 func main() {
-	config, err := config.InitConfig()
+	os.Exit(run())
+}
+
+func run() int {
+	cfg, args, err := config.InitConfig(os.Args[1:])
 	if err != nil {
-		fmt.Printf("Ошибка инициализации конфига: %v\n", err)
-		return
+		fmt.Fprintf(os.Stderr, i18n.T("Ошибка инициализации конфига: %v\n"), err)
+		return 1
+	}
+	if len(cfg.Statuses) != 0 {
+		model.Statuses = cfg.Statuses
+	}
+	app.ListDefaults = cfg.ListDefaults
+
+	if len(args) > 0 && args[0] == "projects" {
+		projects, err := config.ListProjects(cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("Ошибка: %v\n"), err)
+			return 1
+		}
+		return app.PrintProjects(projects, cfg.Project, args[1:])
+	}
+
+	if len(args) > 0 && args[0] == "history" {
+		entries, err := repository.ReadHistory(repository.HistoryFile(cfg.TaskFile))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("Ошибка: %v\n"), err)
+			return 1
+		}
+		return app.PrintHistory(entries, args[1:])
+	}
+
+	watching := len(args) > 0 && args[0] == "watch"
+	if !watching {
+		unlock, err := repository.Lock(cfg.TaskFile, lockTimeout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("Ошибка: %v\n"), err)
+			return 1
+		}
+		defer unlock()
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			unlock()
+			os.Exit(130)
+		}()
+	}
+
+	signal.Ignore(syscall.SIGPIPE)
+
+	warn := func(err error) {
+		fmt.Fprintf(os.Stderr, i18n.T("Предупреждение: %v\n"), err)
+	}
+
+	var repo repository.Store
+	switch cfg.Backend {
+	case config.BackendSQLite:
+		sqliteRepo, err := repository.NewSQLiteTaskRepository(cfg.TaskFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("Ошибка открытия хранилища: %v\n"), err)
+			return 1
+		}
+		defer sqliteRepo.Close()
+		repo = sqliteRepo
+	default:
+		repo = repository.NewTaskRepository(cfg.TaskFile, cfg.Compact, warn)
+	}
+	repo = repository.NewHistoryTaskRepository(repo, repository.HistoryFile(cfg.TaskFile), warn)
+	if cfg.DryRun {
+		repo = repository.NewDryRunTaskRepository(repo)
+		defer fmt.Fprintln(os.Stderr, i18n.T("Пробный запуск: изменения не записаны"))
+	}
+
+	if watching {
+		return app.Watch(service.NewTaskService(repo), args[1:])
+	}
+
+	if len(args) > 0 && (args[0] == "shell" || args[0] == "-i") {
+		cached := repository.NewCachedTaskRepository(repo)
+		return app.Shell(service.NewTaskService(cached), cached.Flush)
 	}
-	repo := repository.NewTaskRepository(config.TaskFile)
-	serv := service.NewTaskService(repo)
 
-	app.Run(serv)
+	return app.Run(service.NewTaskService(repo), args)
 }
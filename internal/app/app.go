@@ -1,168 +1,137 @@
 package app
 
 import (
+	"errors"
 	"fmt"
+	"go-task-cli/internal/i18n"
 	"go-task-cli/internal/model"
+	"io"
 	"os"
 	"strconv"
-	"strings"
+	"time"
 )
 
 type TaskService interface {
-	AddTask(description string) (*model.Task, error)
+	AddTask(description string, parentId int) (*model.Task, error)
+	AddTasks(descriptions []string, parentId int) ([]model.Task, error)
+	CloneTask(id int) (*model.Task, error)
+	ImportTasks(tasks []model.Task) (int, error)
 	UpdateTask(id int, description string) error
-	DeleteTask(id int) error
-	MarkTask(id int, status model.TaskStatus) error
-	ListTasks(status model.TaskStatus) ([]model.Task, error)
+	AppendDescription(id int, text string) error
+	SetNotes(id int, notes string) error
+	GetTask(id int) (*model.Task, error)
+	EditTask(id int, description string, notes string) error
+	DeleteTasks(ids []int, opts model.DeleteOptions) ([]int, error)
+	TrashTasks() ([]model.Task, error)
+	RestoreTask(id int) error
+	PurgeTrash(before time.Time) (int, error)
+	PurgeDone(before time.Time) (int, error)
+	ClearTasks() (int, error)
+	Undo() error
+	MarkTasks(ids []int, status model.TaskStatus, force bool) ([]int, error)
+	MarkTasksInStatus(from model.TaskStatus, status model.TaskStatus, force bool) ([]int, error)
+	AddDependency(id int, dependsOn int) error
+	StartTimer(id int) error
+	StopTimer(id int) (time.Duration, error)
+	TrackedTime(id int, now time.Time) (time.Duration, bool, error)
+	ReopenTask(id int) error
+	MoveTask(id int, position int) error
+	SwapTasks(first int, second int) error
+	MergeTasks(srcId int, dstId int) error
+	SetFields(id int, values map[string]string, force bool) error
+	SetDueDate(id int, dueDate string) (string, error)
+	SnoozeTask(id int, by time.Duration) (string, error)
+	SetPriority(id int, priority model.TaskPriority) error
+	SetAssignee(id int, assignee string) error
+	SetPinned(id int, pinned bool) error
+	SubtaskProgress() (map[int]model.Progress, error)
+	TopTasks(n int) ([]model.Task, error)
+	VerifyFormat(rewrite bool) (bool, error)
+	AddTag(id int, tag string) (bool, error)
+	RemoveTag(id int, tag string) error
+	SetArchived(id int, archived bool) error
+	ListTasks(filter model.TaskFilter) ([]model.Task, error)
+	NextTask() (*model.Task, error)
+	SearchTasks(query string, opts model.SearchOptions) ([]model.Task, error)
+	FindOpenTask(description string) (*model.Task, error)
+	OverdueTasks(now time.Time) ([]model.Task, error)
+	DueTodayTasks(now time.Time) ([]model.Task, error)
+	CountTasks() (map[model.TaskStatus]int, error)
+	Stats() (model.TaskStats, error)
+	Doctor(fix bool) ([]string, error)
 }
 
-func Run(serv TaskService) {
-	if len(os.Args) < 2 {
-		fmt.Println("Использование: task-cli <команда> [аргументы...]")
-		fmt.Println("Команды:")
-		fmt.Println("  add <описание> - Добавить новую задачу")
-		fmt.Println("  update <id> <описание> - Обновить задачу")
-		fmt.Println("  delete <id> - Удалить задачу")
-		fmt.Println("  mark-in-progress <id> - Отметить задачу как в процессе")
-		fmt.Println("  mark-done <id> - Отметить задачу как выполненной")
-		fmt.Println("  list [статус] - Список всех задач или задач по статусу (todo, in-progress, done)")
-		return
-	}
-
-	command := os.Args[1]
-	args := os.Args[2:]
-
-	switch command {
-	case "add":
-		if len(args) < 1 {
-			fmt.Println("Использование: task-cli add <описание>")
-			return
-		}
-
-		task, err := serv.AddTask(strings.Join(args, " "))
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача добавлена успешно (ID: %d)\n", task.Id)
-	case "update":
-		if len(args) < 2 {
-			fmt.Println("Использование: task-cli update <id> <описание>")
-			return
-		}
-
-		id, err := strconv.Atoi(args[0])
-		if err != nil {
-			fmt.Printf("Неверный идентификатор задачи: %v\n", err)
-			return
-		}
-
-		err = serv.UpdateTask(id, strings.Join(args[1:], " "))
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача обновлена успешно (ID: %d)\n", id)
-	case "delete":
-		if len(args) != 1 {
-			fmt.Println("Использование: task-cli delete <id>")
-			return
-		}
-
-		id, err := strconv.Atoi(args[0])
-		if err != nil {
-			fmt.Printf("Неверный идентификатор задачи: %v\n", err)
-			return
-		}
+type usageError string
 
-		err = serv.DeleteTask(id)
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача удалена (ID: %d)\n", id)
-	case "mark-todo":
-		if len(args) != 1 {
-			fmt.Println("Использование: task-cli mark-todo <id>")
-			return
-		}
+func (e usageError) Error() string {
+	return i18n.T("Использование: task-cli ") + string(e)
+}
 
-		id, err := strconv.Atoi(args[0])
-		if err != nil {
-			fmt.Printf("Неверный идентификатор задачи: %v\n", err)
-			return
-		}
+func Run(serv TaskService, args []string) int {
+	if len(args) < 1 {
+		printUsage(os.Stderr)
+		return 1
+	}
 
-		err = serv.MarkTask(id, model.StatusTodo)
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача пометлена как TODO (ID: %d)\n", id)
-	case "mark-in-progress":
-		if len(args) != 1 {
-			fmt.Println("Использование: task-cli mark-in-progress <id>")
-			return
-		}
+	if err := runCommand(serv, args[0], args[1:]); err != nil {
+		printError(err)
+		return 1
+	}
 
-		id, err := strconv.Atoi(args[0])
-		if err != nil {
-			fmt.Printf("Неверный идентификатор задачи: %v\n", err)
-			return
-		}
+	return 0
+}
 
-		err = serv.MarkTask(id, model.StatusInProgress)
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача пометлена как в процессе (ID: %d)\n", id)
-	case "mark-done":
-		if len(args) != 1 {
-			fmt.Println("Использование: task-cli mark-done <id>")
-			return
-		}
+func printError(err error) {
+	if err == nil {
+		return
+	}
 
-		id, err := strconv.Atoi(args[0])
-		if err != nil {
-			fmt.Printf("Неверный идентификатор задачи: %v\n", err)
-			return
-		}
+	var usage usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintln(os.Stderr, usage)
+	} else {
+		fmt.Fprintf(os.Stderr, i18n.T("Ошибка: %v\n"), err)
+	}
+}
 
-		err = serv.MarkTask(id, model.StatusDone)
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
-		fmt.Printf("Задача пометлена как выполненная (ID: %d)\n", id)
-	case "list":
-		var status model.TaskStatus
-		if len(args) != 0 {
-			status = model.TaskStatus(args[0])
-		}
+func runCommand(serv TaskService, name string, args []string) error {
+	cmd, err := lookupCommand(name)
+	if err != nil {
+		return err
+	}
+	if wantsHelp(args) {
+		printCommandHelp(os.Stdout, cmd)
+		return nil
+	}
 
-		tasks, err := serv.ListTasks(status)
-		if err != nil {
-			fmt.Printf("Ошибка: %v\n", err)
-			return
-		}
+	return cmd.run(serv, args)
+}
 
-		if len(tasks) == 0 {
-			fmt.Println("Задачи не найдены.")
-			return
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, i18n.T("Использование: task-cli [--file <путь>] [--backend <json|sqlite>] [--project <имя>] [--dry-run] [--compact] <команда> [аргументы...]"))
+	fmt.Fprintln(w, i18n.T("Команды:"))
+	for _, cmd := range commands {
+		if !cmd.hidden {
+			fmt.Fprintln(w, "  "+cmd.usageLine())
 		}
+	}
+	fmt.Fprintln(w, i18n.T("Флаги:"))
+	fmt.Fprintln(w, i18n.T("  --file <путь> - Файл задач (приоритетнее TASK_CLI_FILE)"))
+	fmt.Fprintln(w, i18n.T("  --backend <json|sqlite> - Хранилище задач (приоритетнее TASK_CLI_BACKEND)"))
+	fmt.Fprintln(w, i18n.T("  --project <имя> - Проект со своим списком задач (приоритетнее TASK_CLI_PROJECT)"))
+	fmt.Fprintln(w, i18n.T("  --dry-run - Показать результат команды, не записывая изменения"))
+	fmt.Fprintln(w, i18n.T("  --compact - Сохранять файл задач в одну строку (как TASK_CLI_COMPACT=1)"))
+	fmt.Fprintln(w, i18n.T("Подробнее о команде: task-cli help <команда> или task-cli <команда> --help"))
+}
 
-		fmt.Println("Задачи:")
-		for _, task := range tasks {
-			fmt.Println("ID:", task.Id)
-			fmt.Println("Описание:", task.Description)
-			fmt.Println("Статус:", task.Status)
-			fmt.Println("Создано:", task.CreatedAt)
-			fmt.Println("Обновлено:", task.UpdatedAt)
-			fmt.Println("-------------------")
-		}
-	default:
-		fmt.Printf("Неверная команда: %s\n", command)
-		return
+func parseId(arg string) (int, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("неверный идентификатор задачи %q"), arg)
 	}
+	if id < 1 {
+		return 0, fmt.Errorf(i18n.T("идентификатор задачи должен быть положительным: %s"), arg)
+	}
+
+	return id, nil
 }
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"go-task-cli/internal/model"
+	"os"
+)
+
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+	ansiBlue   = "\033[34m"
+)
+
+type colorizer struct {
+	enabled bool
+}
+
+var colors = colorizer{enabled: colorEnabled()}
+
+func colorEnabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func (c colorizer) paint(s string, color string) string {
+	if !c.enabled || color == "" {
+		return s
+	}
+
+	return color + s + ansiReset
+}
+
+func (c colorizer) priority(priority model.TaskPriority, text string) string {
+	switch priority {
+	case model.PriorityHigh:
+		return c.paint(text, ansiRed)
+	case model.PriorityMedium:
+		return c.paint(text, ansiYellow)
+	case model.PriorityLow:
+		return c.paint(text, ansiGreen)
+	default:
+		return text
+	}
+}
+
+func (c colorizer) status(status model.TaskStatus, text string) string {
+	switch status {
+	case model.StatusTodo:
+		return c.paint(text, ansiYellow)
+	case model.StatusInProgress:
+		return c.paint(text, ansiBlue)
+	case model.StatusDone:
+		return c.paint(text, ansiGreen)
+	default:
+		return text
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"go-task-cli/internal/model"
+	"testing"
+)
+
+func TestStatusColor(t *testing.T) {
+	tests := []struct {
+		status model.TaskStatus
+		want   string
+	}{
+		{model.StatusTodo, ansiYellow + "x" + ansiReset},
+		{model.StatusInProgress, ansiBlue + "x" + ansiReset},
+		{model.StatusDone, ansiGreen + "x" + ansiReset},
+		{"custom", "x"},
+	}
+
+	enabled := colorizer{enabled: true}
+	for _, tt := range tests {
+		if got := enabled.status(tt.status, "x"); got != tt.want {
+			t.Errorf("status(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+		if got := (colorizer{}).status(tt.status, "x"); got != "x" {
+			t.Errorf("status(%q) without color = %q, want %q", tt.status, got, "x")
+		}
+	}
+}
+
+func TestColorDisabledByNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	if colorEnabled() {
+		t.Error("colorEnabled() = true with NO_COLOR set")
+	}
+}
+
+func TestPriorityColor(t *testing.T) {
+	tests := []struct {
+		priority model.TaskPriority
+		want     string
+	}{
+		{model.PriorityHigh, ansiRed + "x" + ansiReset},
+		{model.PriorityMedium, ansiYellow + "x" + ansiReset},
+		{model.PriorityLow, ansiGreen + "x" + ansiReset},
+		{"legacy", "x"},
+	}
+
+	enabled := colorizer{enabled: true}
+	for _, tt := range tests {
+		if got := enabled.priority(tt.priority, "x"); got != tt.want {
+			t.Errorf("priority(%q) = %q, want %q", tt.priority, got, tt.want)
+		}
+		if got := (colorizer{}).priority(tt.priority, "x"); got != "x" {
+			t.Errorf("priority(%q) without color = %q, want %q", tt.priority, got, "x")
+		}
+	}
+}
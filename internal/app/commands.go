@@ -0,0 +1,731 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func cmdAdd(serv TaskService, args []string) error {
+	fs := newFlagSet("add")
+	parentId := fs.Int("parent", 0, "")
+	fromFile := fs.String("from-file", "", "")
+	noDup := fs.Bool("no-dup", false, "")
+	var quiet bool
+	fs.BoolVar(&quiet, "q", false, "")
+	fs.BoolVar(&quiet, "quiet", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *fromFile != "" {
+		if *noDup {
+			return errors.New(i18n.T("флаг --no-dup несовместим с --from-file"))
+		}
+		if len(args) != 0 {
+			return usageError(i18n.T("add [-q] [--parent <id>] --from-file <файл|->"))
+		}
+		return addFromFile(serv, *fromFile, *parentId, quiet)
+	}
+	if len(args) < 1 {
+		return usageError(i18n.T("add [-q] [--parent <id>] [--no-dup] <описание|->"))
+	}
+
+	description := strings.Join(args, " ")
+	if description == "-" {
+		if description, err = readStdin(); err != nil {
+			return err
+		}
+	}
+
+	if *noDup {
+		existing, err := serv.FindOpenTask(description)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			fmt.Println(existing.Id)
+			if !quiet {
+				fmt.Fprintf(os.Stderr, i18n.T("Такая задача уже есть (ID: %d)\n"), existing.Id)
+			}
+			return nil
+		}
+	}
+
+	task, err := serv.AddTask(description, *parentId)
+	if err != nil {
+		return err
+	}
+	fmt.Println(task.Id)
+	if !quiet {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача добавлена успешно (ID: %d)\n"), task.Id)
+	}
+
+	return nil
+}
+
+func addFromFile(serv TaskService, path string, parentId int, quiet bool) error {
+	descriptions, err := readLines(path)
+	if err != nil {
+		return err
+	}
+
+	var added []model.Task
+	if len(descriptions) != 0 {
+		added, err = serv.AddTasks(descriptions, parentId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, task := range added {
+		fmt.Println(task.Id)
+	}
+	if !quiet {
+		fmt.Fprintf(os.Stderr, i18n.T("Добавлено задач: %d\n"), len(added))
+	}
+
+	return nil
+}
+
+func readStdin() (string, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка чтения stdin: %v"), err)
+	}
+
+	text := strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(text, "\r"), nil
+}
+
+func readLines(path string) ([]string, error) {
+	file := os.Stdin
+	if path != "-" {
+		var err error
+		file, err = os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ошибка открытия файла: %v"), err)
+		}
+		defer file.Close()
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка чтения файла: %v"), err)
+	}
+
+	return lines, nil
+}
+
+func cmdClone(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("clone <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	task, err := serv.CloneTask(id)
+	if err != nil {
+		return err
+	}
+	fmt.Println(task.Id)
+	fmt.Fprintf(os.Stderr, i18n.T("Задача скопирована (ID: %d -> %d)\n"), id, task.Id)
+
+	return nil
+}
+
+func cmdUpdate(serv TaskService, args []string) error {
+	if len(args) < 2 {
+		return usageError(i18n.T("update <id> <описание>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = serv.UpdateTask(id, strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача обновлена успешно (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdAppend(serv TaskService, args []string) error {
+	if len(args) < 2 {
+		return usageError(i18n.T("append <id> <текст>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = serv.AppendDescription(id, strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Описание задачи дополнено (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdNote(serv TaskService, args []string) error {
+	if len(args) < 1 {
+		return usageError(i18n.T("note <id> [текст|-]"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	notes := strings.Join(args[1:], " ")
+	if notes == "-" {
+		if notes, err = readStdin(); err != nil {
+			return err
+		}
+	}
+
+	err = serv.SetNotes(id, notes)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(notes) == "" {
+		fmt.Fprintf(os.Stderr, i18n.T("Заметки задачи очищены (ID: %d)\n"), id)
+	} else {
+		fmt.Fprintf(os.Stderr, i18n.T("Заметки задачи сохранены (ID: %d)\n"), id)
+	}
+
+	return nil
+}
+
+func cmdDelete(serv TaskService, args []string) error {
+	var opts model.DeleteOptions
+	fs := newFlagSet("delete")
+	fs.BoolVar(&opts.Hard, "hard", false, "")
+	fs.BoolVar(&opts.Cascade, "cascade", false, "")
+	force := fs.Bool("force", false, "")
+	fs.BoolVar(force, "y", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return usageError(i18n.T("delete [--hard] [--cascade] [--force|-y] <id|диапазон>[,...]"))
+	}
+
+	ids, err := parseIds(args)
+	if err != nil {
+		return err
+	}
+
+	if !*force && stdinIsTerminal() {
+		if err := printDeleteTargets(serv, ids); err != nil {
+			return err
+		}
+	}
+	ok, err := confirmUnlessForced(i18n.T("Удалить?"), *force)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Fprintln(os.Stderr, i18n.T("Отменено"))
+		return nil
+	}
+
+	notFound, err := serv.DeleteTasks(ids, opts)
+	if err != nil {
+		return err
+	}
+
+	deleted := slices.DeleteFunc(ids, func(id int) bool {
+		return slices.Contains(notFound, id)
+	})
+	if len(deleted) != 0 {
+		if opts.Hard {
+			fmt.Fprintf(os.Stderr, i18n.T("Задачи удалены (ID: %s)\n"), model.JoinIds(deleted))
+		} else {
+			fmt.Fprintf(os.Stderr, i18n.T("Задачи перемещены в корзину (ID: %s)\n"), model.JoinIds(deleted))
+		}
+	}
+	if len(notFound) != 0 {
+		return fmt.Errorf(i18n.T("задачи не найдены (ID: %s)"), model.JoinIds(notFound))
+	}
+
+	return nil
+}
+
+func printDeleteTargets(serv TaskService, ids []int) error {
+	tasks, err := serv.ListTasks(model.TaskFilter{IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if slices.Contains(ids, task.Id) {
+			fmt.Fprintf(os.Stderr, "[%d] %s\n", task.Id, task.Description)
+		}
+	}
+
+	return nil
+}
+
+func cmdClear(serv TaskService, args []string) error {
+	fs := newFlagSet("clear")
+	force := fs.Bool("force", false, "")
+	fs.BoolVar(force, "f", false, "")
+	fs.BoolVar(force, "y", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError("clear [--force|-f|-y]")
+	}
+
+	ok, err := confirmUnlessForced(i18n.T("Удалить все задачи?"), *force)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Fprintln(os.Stderr, i18n.T("Отменено"))
+		return nil
+	}
+
+	count, err := serv.ClearTasks()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Удалено задач: %d\n"), count)
+
+	return nil
+}
+
+func cmdUndo(serv TaskService, args []string) error {
+	if len(args) != 0 {
+		return usageError("undo")
+	}
+
+	if err := serv.Undo(); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, i18n.T("Последнее изменение отменено"))
+
+	return nil
+}
+
+func cmdMark(serv TaskService, command string, args []string, status model.TaskStatus, report func(id int)) error {
+	fs := newFlagSet(command)
+	force := fs.Bool("force", false, "")
+	all := fs.Bool("all", false, "")
+	from := fs.String("status", "", "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *all || *from != "" {
+		if len(args) != 0 || (*all && *from != "") {
+			return usageError(command + i18n.T(" [--force] <id|диапазон>[,...] [id...] | --all | --status <статус>"))
+		}
+		changed, err := serv.MarkTasksInStatus(model.TaskStatus(*from), status, *force)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, i18n.T("Изменено задач: %d\n"), len(changed))
+		return nil
+	}
+	if len(args) < 1 {
+		return usageError(command + i18n.T(" [--force] <id|диапазон>[,...] [id...] | --all | --status <статус>"))
+	}
+
+	ids, err := parseIds(args)
+	if err != nil {
+		return err
+	}
+
+	notFound, err := serv.MarkTasks(ids, status, *force)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if !slices.Contains(notFound, id) {
+			report(id)
+		}
+	}
+
+	if len(notFound) != 0 {
+		return fmt.Errorf(i18n.T("задачи не найдены (ID: %s)"), model.JoinIds(notFound))
+	}
+
+	return nil
+}
+
+func cmdMarkStatus(serv TaskService, args []string) error {
+	if len(args) < 2 {
+		return usageError(i18n.T("mark [--force] <id|диапазон>[,...] [id...] <статус>"))
+	}
+
+	status := model.TaskStatus(args[len(args)-1])
+	return cmdMark(serv, "mark", args[:len(args)-1], status, func(id int) {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача переведена в статус %s (ID: %d)\n"), status, id)
+	})
+}
+
+func cmdReopen(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("reopen <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.ReopenTask(id); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача возвращена в работу (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdDepend(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("depend <id> <id зависимости>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+	dependsOn, err := parseId(args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.AddDependency(id, dependsOn); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача %d теперь зависит от задачи %d\n"), id, dependsOn)
+
+	return nil
+}
+
+func cmdMove(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("move <id> <позиция>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	position, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf(i18n.T("неверная позиция %q"), args[1])
+	}
+
+	if err := serv.MoveTask(id, position); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача перемещена на позицию %d (ID: %d)\n"), position, id)
+
+	return nil
+}
+
+func cmdSwap(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError("swap <id> <id>")
+	}
+
+	first, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+	second, err := parseId(args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.SwapTasks(first, second); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задачи поменяны местами (ID: %d, %d)\n"), first, second)
+
+	return nil
+}
+
+func cmdMerge(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("merge <id источника> <id назначения>"))
+	}
+
+	srcId, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+	dstId, err := parseId(args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.MergeTasks(srcId, dstId); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача %d объединена с задачей %d и перемещена в корзину\n"), srcId, dstId)
+
+	return nil
+}
+
+func cmdSet(serv TaskService, args []string) error {
+	fs := newFlagSet("set")
+	force := fs.Bool("force", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return usageError(i18n.T("set [--force] <id> <поле>=<значение> [...]"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(args)-1)
+	for _, arg := range args[1:] {
+		field, value, ok := strings.Cut(arg, "=")
+		if !ok || field == "" {
+			return fmt.Errorf(i18n.T("неверное присваивание %q (ожидается поле=значение)"), arg)
+		}
+		if _, seen := values[field]; seen {
+			return fmt.Errorf(i18n.T("поле %q указано несколько раз"), field)
+		}
+		values[field] = value
+	}
+
+	if err := serv.SetFields(id, values, *force); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача обновлена успешно (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdDue(serv TaskService, args []string) error {
+	if len(args) < 2 {
+		return usageError(i18n.T("due <id> <дата>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	dueDate, err := serv.SetDueDate(id, strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Срок задачи установлен на %s (ID: %d)\n"), dueDate, id)
+
+	return nil
+}
+
+func cmdSnooze(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("snooze <id> <срок>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	by, err := timeutil.ParseAge(args[1])
+	if err != nil {
+		return err
+	}
+
+	dueDate, err := serv.SnoozeTask(id, by)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача отложена, новый срок: %s (ID: %d)\n"), dueDate, id)
+
+	return nil
+}
+
+func cmdPriority(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError("priority <id> <low|medium|high>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = serv.SetPriority(id, model.TaskPriority(args[1]))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Приоритет задачи установлен: %s (ID: %d)\n"), args[1], id)
+
+	return nil
+}
+
+func cmdAssign(serv TaskService, args []string) error {
+	if len(args) < 2 {
+		return usageError(i18n.T("assign <id> <имя>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	assignee := strings.TrimSpace(strings.Join(args[1:], " "))
+	if assignee == "" {
+		return usageError(i18n.T("assign <id> <имя>"))
+	}
+
+	err = serv.SetAssignee(id, assignee)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача назначена на %s (ID: %d)\n"), assignee, id)
+
+	return nil
+}
+
+func cmdUnassign(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("unassign <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = serv.SetAssignee(id, "")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Исполнитель снят (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdTag(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("tag <id> <тег>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	added, err := serv.AddTag(id, args[1])
+	if err != nil {
+		return err
+	}
+	if !added {
+		fmt.Fprintf(os.Stderr, i18n.T("Тег %q уже есть у задачи (ID: %d)\n"), args[1], id)
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Тег %q добавлен (ID: %d)\n"), args[1], id)
+
+	return nil
+}
+
+func cmdUntag(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("untag <id> <тег>"))
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = serv.RemoveTag(id, args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Тег %q удален (ID: %d)\n"), args[1], id)
+
+	return nil
+}
+
+func cmdPin(serv TaskService, command string, args []string) error {
+	if len(args) != 1 {
+		return usageError(command + " <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	pinned := command == "pin"
+	err = serv.SetPinned(id, pinned)
+	if err != nil {
+		return err
+	}
+	if pinned {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача закреплена (ID: %d)\n"), id)
+	} else {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача откреплена (ID: %d)\n"), id)
+	}
+
+	return nil
+}
+
+func cmdArchive(serv TaskService, command string, args []string) error {
+	if len(args) != 1 {
+		return usageError(command + " <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	archived := command == "archive"
+	err = serv.SetArchived(id, archived)
+	if err != nil {
+		return err
+	}
+	if archived {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача перемещена в архив (ID: %d)\n"), id)
+	} else {
+		fmt.Fprintf(os.Stderr, i18n.T("Задача возвращена из архива (ID: %d)\n"), id)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strings"
+)
+
+func cmdCompletion(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("completion <bash|zsh|fish>")
+	}
+
+	switch args[0] {
+	case "bash":
+		fmt.Print(bashCompletion())
+	case "zsh":
+		fmt.Print(zshCompletion())
+	case "fish":
+		fmt.Print(fishCompletion())
+	default:
+		return fmt.Errorf(i18n.T("неподдерживаемая оболочка %q (допустимо: bash, zsh, fish)"), args[0])
+	}
+
+	return nil
+}
+
+func cmdCompleteIds(serv TaskService, args []string) error {
+	tasks, err := serv.ListTasks(model.TaskFilter{IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		fmt.Println(task.Id)
+	}
+
+	return nil
+}
+
+func completionNames(idsOnly bool) []string {
+	var names []string
+	for _, cmd := range commands {
+		if cmd.hidden || (idsOnly && !cmd.takesId) {
+			continue
+		}
+		names = append(names, cmd.name)
+		names = append(names, cmd.aliases...)
+	}
+
+	return names
+}
+
+func bashCompletion() string {
+	var b strings.Builder
+	b.WriteString("_task_cli() {\n")
+	b.WriteString("    local cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	b.WriteString("    if [ \"$COMP_CWORD\" -eq 1 ]; then\n")
+	fmt.Fprintf(&b, "        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(completionNames(false), " "))
+	b.WriteString("        return\n")
+	b.WriteString("    fi\n")
+	b.WriteString("    case \"${COMP_WORDS[1]}\" in\n")
+	fmt.Fprintf(&b, "        %s)\n", strings.Join(completionNames(true), "|"))
+	b.WriteString("            COMPREPLY=($(compgen -W \"$(task-cli __complete-ids 2>/dev/null)\" -- \"$cur\"))\n")
+	b.WriteString("            ;;\n")
+	b.WriteString("    esac\n")
+	b.WriteString("}\n")
+	b.WriteString("complete -F _task_cli task-cli\n")
+	return b.String()
+}
+
+func zshCompletion() string {
+	var b strings.Builder
+	b.WriteString("#compdef task-cli\n\n")
+	b.WriteString("_task_cli() {\n")
+	b.WriteString("    local -a commands\n")
+	b.WriteString("    commands=(\n")
+	for _, cmd := range commands {
+		if cmd.hidden {
+			continue
+		}
+		for _, name := range append([]string{cmd.name}, cmd.aliases...) {
+			fmt.Fprintf(&b, "        %s\n", shellQuote(strings.ReplaceAll(name, ":", "\\:")+":"+cmd.summary))
+		}
+	}
+	b.WriteString("    )\n")
+	b.WriteString("    if (( CURRENT == 2 )); then\n")
+	b.WriteString(i18n.T("        _describe 'команда' commands\n"))
+	b.WriteString("        return\n")
+	b.WriteString("    fi\n")
+	b.WriteString("    case $words[2] in\n")
+	fmt.Fprintf(&b, "        %s)\n", strings.Join(completionNames(true), "|"))
+	b.WriteString("            compadd -- ${(f)\"$(task-cli __complete-ids 2>/dev/null)\"}\n")
+	b.WriteString("            ;;\n")
+	b.WriteString("    esac\n")
+	b.WriteString("}\n\n")
+	b.WriteString("compdef _task_cli task-cli\n")
+	return b.String()
+}
+
+func fishCompletion() string {
+	var b strings.Builder
+	b.WriteString("complete -c task-cli -f\n")
+	for _, cmd := range commands {
+		if cmd.hidden {
+			continue
+		}
+		for _, name := range append([]string{cmd.name}, cmd.aliases...) {
+			fmt.Fprintf(&b, "complete -c task-cli -n __fish_use_subcommand -a %s -d %s\n", shellQuote(name), shellQuote(cmd.summary))
+		}
+	}
+	fmt.Fprintf(&b, "complete -c task-cli -n %s -a '(task-cli __complete-ids 2>/dev/null)'\n", shellQuote("__fish_seen_subcommand_from "+strings.Join(completionNames(true), " ")))
+	return b.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompletionNamesIdsOnly(t *testing.T) {
+	names := completionNames(true)
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"delete", true},
+		{"rm", true},
+		{"mark-done", true},
+		{"done", true},
+		{"update", true},
+		{"rename", true},
+		{"list", false},
+		{"ls", false},
+	}
+
+	for _, tt := range tests {
+		if got := slices.Contains(names, tt.name); got != tt.want {
+			t.Errorf("completionNames(true) contains %q = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+)
+
+func cmdDoctor(serv TaskService, args []string) error {
+	fs := newFlagSet("doctor")
+	fix := fs.Bool("fix", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError("doctor [--fix]")
+	}
+
+	problems, err := serv.Doctor(*fix)
+	if err != nil {
+		return fmt.Errorf(i18n.T("файл задач не удалось прочитать, исправьте его вручную: %w"), err)
+	}
+
+	if len(problems) == 0 {
+		fmt.Println(i18n.T("Проблем не найдено"))
+		return nil
+	}
+
+	fmt.Println(i18n.T("Найдены проблемы:"))
+	for _, problem := range problems {
+		fmt.Println("  " + problem)
+	}
+
+	if *fix {
+		fmt.Println(i18n.T("Проблемы исправлены: ID сделаны уникальными, неверные статусы заменены на todo, отсутствующие метки времени заполнены"))
+		return nil
+	}
+
+	return fmt.Errorf(i18n.T("найдено проблем: %d, запустите doctor --fix для исправления"), len(problems))
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const defaultEditor = "vi"
+
+func cmdEdit(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("edit <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	task, err := serv.GetTask(id)
+	if err != nil {
+		return err
+	}
+
+	separator := i18n.T("--- заметки ---")
+	content := task.Description + "\n\n" + separator + "\n"
+	if task.Notes != "" {
+		content += task.Notes + "\n"
+	}
+
+	edited, err := editText(content)
+	if err != nil {
+		return err
+	}
+
+	description, notes := edited, ""
+	lines := strings.Split(edited, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == separator {
+			description = strings.Join(lines[:i], "\n")
+			notes = strings.Join(lines[i+1:], "\n")
+			break
+		}
+	}
+
+	if strings.TrimSpace(description) == task.Description && strings.TrimSpace(notes) == task.Notes {
+		fmt.Fprintf(os.Stderr, i18n.T("Изменений нет (ID: %d)\n"), id)
+		return nil
+	}
+
+	if err := serv.EditTask(id, description, notes); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача обновлена успешно (ID: %d)\n"), id)
+
+	return nil
+}
+
+func editText(content string) (string, error) {
+	editor := strings.Fields(editorCommand())
+	if _, err := exec.LookPath(editor[0]); err != nil {
+		return "", fmt.Errorf(i18n.T("редактор %q не найден, укажите его в переменной EDITOR"), editor[0])
+	}
+
+	file, err := os.CreateTemp("", "task-cli-*.txt")
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка создания временного файла: %v"), err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка записи временного файла: %v"), err)
+	}
+
+	cmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf(i18n.T("редактор завершился с ошибкой, задача не изменена: %v"), err)
+		}
+		return "", fmt.Errorf(i18n.T("ошибка запуска редактора: %v"), err)
+	}
+
+	data, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка чтения временного файла: %v"), err)
+	}
+
+	return string(data), nil
+}
+
+func editorCommand() string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(name)); editor != "" {
+			return editor
+		}
+	}
+
+	return defaultEditor
+}
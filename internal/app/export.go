@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/export"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"io"
+	"os"
+	"slices"
+)
+
+func cmdExport(serv TaskService, args []string) error {
+	var filter model.TaskFilter
+	fs := newFlagSet("export")
+	filters := addFilterFlags(fs, &filter)
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 || len(args) > 2 {
+		return usageError(i18n.T("export <csv|md|json|ics|todotxt> [флаги фильтра list] [файл]"))
+	}
+	if err := filters.apply(&filter); err != nil {
+		return err
+	}
+
+	var write func(io.Writer, []model.Task) error
+	switch args[0] {
+	case "csv":
+		write = export.WriteCSV
+	case "md":
+		write = export.WriteMarkdown
+	case "json":
+		write = export.WriteJSON
+	case "ics":
+		write = export.WriteICS
+	case "todotxt":
+		write = export.WriteTodoTxt
+	default:
+		return fmt.Errorf(i18n.T("неверный формат экспорта: %s"), args[0])
+	}
+
+	tasks, err := serv.ListTasks(filter)
+	if err != nil {
+		return err
+	}
+	if args[0] == "ics" {
+		tasks = slices.DeleteFunc(tasks, func(task model.Task) bool {
+			return task.DueDate == ""
+		})
+	}
+
+	if len(args) == 1 {
+		return write(os.Stdout, tasks)
+	}
+
+	file, err := os.Create(args[1])
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка создания файла экспорта: %v"), err)
+	}
+
+	if err := write(file, tasks); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла экспорта: %v"), err)
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Экспортировано задач: %d (%s)\n"), len(tasks), args[1])
+
+	return nil
+}
+
+func cmdImport(serv TaskService, args []string) error {
+	if len(args) != 2 {
+		return usageError(i18n.T("import <csv|todotxt> <файл>"))
+	}
+
+	var read func(io.Reader) ([]model.Task, []error, error)
+	switch args[0] {
+	case "csv":
+		read = export.ReadCSV
+	case "todotxt":
+		read = export.ReadTodoTxt
+	default:
+		return fmt.Errorf(i18n.T("неверный формат импорта: %s"), args[0])
+	}
+
+	file, err := os.Open(args[1])
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка открытия файла импорта: %v"), err)
+	}
+	defer file.Close()
+
+	tasks, skipped, err := read(file)
+	if err != nil {
+		return err
+	}
+
+	for _, err := range skipped {
+		fmt.Fprintf(os.Stderr, i18n.T("Пропущено: %v\n"), err)
+	}
+
+	imported, err := serv.ImportTasks(tasks)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Импортировано задач: %d, пропущено: %d\n"), imported, len(skipped))
+
+	return nil
+}
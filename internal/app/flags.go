@@ -0,0 +1,136 @@
+package app
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type filterFlags struct {
+	status      string
+	statusNames []model.TaskStatus
+	statuses    []bool
+	since       string
+}
+
+func addFilterFlags(fs *flag.FlagSet, filter *model.TaskFilter) *filterFlags {
+	f := &filterFlags{}
+	fs.StringVar(&f.status, "status", "", "")
+	fs.StringVar(&filter.Tag, "tag", "", "")
+	fs.StringVar(&filter.Assignee, "assignee", "", "")
+	fs.BoolVar(&filter.Archived, "archived", false, "")
+	fs.BoolVar(&filter.Blocked, "blocked", false, "")
+	fs.BoolVar(&filter.Pinned, "pinned", false, "")
+	fs.StringVar(&f.since, "since", "", "")
+	for _, status := range model.Statuses {
+		if fs.Lookup(string(status)) == nil {
+			f.statusNames = append(f.statusNames, status)
+		}
+	}
+	f.statuses = make([]bool, len(f.statusNames))
+	for i, status := range f.statusNames {
+		fs.BoolVar(&f.statuses[i], string(status), false, "")
+	}
+	return f
+}
+
+func (f *filterFlags) explicitStatus() bool {
+	return f.status != "" || slices.Contains(f.statuses, true)
+}
+
+func (f *filterFlags) apply(filter *model.TaskFilter) error {
+	for i, status := range f.statusNames {
+		if f.statuses[i] {
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+	if f.status != "" {
+		if len(filter.Statuses) != 0 {
+			return errors.New(i18n.T("флаг --status несовместим с --todo, --in-progress и --done"))
+		}
+		filter.Status = model.TaskStatus(f.status)
+	}
+
+	if f.since != "" {
+		since, err := timeutil.ParseSince(f.since, time.Now())
+		if err != nil {
+			return err
+		}
+		filter.Since = since
+	}
+
+	return nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		if isNegativeNumber(args[0]) {
+			positional = append(positional, args[0])
+			args = args[1:]
+			continue
+		}
+
+		end := len(args)
+		for i, arg := range args {
+			if arg == "--" {
+				break
+			}
+			if isNegativeNumber(arg) && !flagTakesValue(fs, args[i-1]) {
+				end = i
+				break
+			}
+		}
+
+		if err := fs.Parse(args[:end]); err != nil {
+			return nil, fmt.Errorf(i18n.T("неверные флаги: %v"), err)
+		}
+
+		consumed := end - fs.NArg()
+		if consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, fs.Args()...), nil
+		}
+
+		rest := slices.Concat(fs.Args(), args[end:])
+		if fs.NArg() > 0 {
+			positional = append(positional, rest[0])
+			rest = rest[1:]
+		}
+		args = rest
+	}
+
+	return positional, nil
+}
+
+func isNegativeNumber(arg string) bool {
+	n, err := strconv.Atoi(arg)
+	return err == nil && n < 0
+}
+
+func flagTakesValue(fs *flag.FlagSet, arg string) bool {
+	name, ok := strings.CutPrefix(arg, "-")
+	if !ok || strings.Contains(name, "=") {
+		return false
+	}
+
+	f := fs.Lookup(strings.TrimPrefix(name, "-"))
+	if f == nil {
+		return false
+	}
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return !ok || !boolFlag.IsBoolFlag()
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"go-task-cli/internal/model"
+	"slices"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      []string
+		wantForce bool
+		wantLimit int
+	}{
+		{"positional only", []string{"1", "2"}, []string{"1", "2"}, false, 0},
+		{"flags anywhere", []string{"1", "--force", "2"}, []string{"1", "2"}, true, 0},
+		{"negative id", []string{"-3"}, []string{"-3"}, false, 0},
+		{"negative id after flag", []string{"--force", "-3"}, []string{"-3"}, true, 0},
+		{"negative id before flag", []string{"-3", "--force", "4"}, []string{"-3", "4"}, true, 0},
+		{"negative flag value", []string{"--limit", "-3", "5"}, []string{"5"}, false, -3},
+		{"double dash", []string{"--force", "--", "--limit"}, []string{"--limit"}, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newFlagSet("test")
+			force := fs.Bool("force", false, "")
+			limit := fs.Int("limit", 0, "")
+
+			got, err := parseFlags(fs, tt.args)
+			if err != nil {
+				t.Fatalf("parseFlags(%q): %v", tt.args, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseFlags(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+			if *force != tt.wantForce || *limit != tt.wantLimit {
+				t.Errorf("parseFlags(%q): force=%v limit=%d, want force=%v limit=%d", tt.args, *force, *limit, tt.wantForce, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestParseFlagsUnknown(t *testing.T) {
+	if _, err := parseFlags(newFlagSet("test"), []string{"--nope"}); err == nil {
+		t.Error("expected error for unknown flag")
+	}
+}
+
+func TestFilterFlagsCustomStatuses(t *testing.T) {
+	saved := model.Statuses
+	t.Cleanup(func() { model.Statuses = saved })
+	model.Statuses = []model.TaskStatus{model.StatusTodo, model.StatusInProgress, "review", "blocked", model.StatusDone}
+
+	var filter model.TaskFilter
+	fs := newFlagSet("test")
+	filters := addFilterFlags(fs, &filter)
+	if _, err := parseFlags(fs, []string{"--review", "--done", "--blocked"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := filters.apply(&filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []model.TaskStatus{"review", model.StatusDone}; !slices.Equal(filter.Statuses, want) {
+		t.Errorf("Statuses = %v, want %v", filter.Statuses, want)
+	}
+	if !filter.Blocked {
+		t.Error("--blocked no longer selects blocked tasks")
+	}
+}
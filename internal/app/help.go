@@ -0,0 +1,118 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+const suggestMaxDistance = 2
+
+func cmdHelp(serv TaskService, args []string) error {
+	if len(args) > 1 {
+		return usageError(i18n.T("help [команда]"))
+	}
+	if len(args) == 0 {
+		printUsage(os.Stdout)
+		return nil
+	}
+
+	cmd, err := lookupCommand(args[0])
+	if err != nil {
+		return err
+	}
+	printCommandHelp(os.Stdout, cmd)
+
+	return nil
+}
+
+func lookupCommand(name string) (command, error) {
+	cmd, ok := findCommand(name)
+	if ok {
+		return cmd, nil
+	}
+
+	if suggestion, ok := suggestCommand(name); ok {
+		return command{}, fmt.Errorf(i18n.T("неверная команда: %s, возможно, вы имели в виду '%s'?"), name, suggestion)
+	}
+
+	return command{}, fmt.Errorf(i18n.T("неверная команда: %s"), name)
+}
+
+func wantsHelp(args []string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			return false
+		}
+		if arg == "--help" || arg == "-h" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func printCommandHelp(w io.Writer, cmd command) {
+	usage := "task-cli " + cmd.name
+	if cmd.args != "" {
+		usage += " " + cmd.args
+	}
+	fmt.Fprintln(w, i18n.T("Использование:"), usage)
+	fmt.Fprintln(w, cmd.summary)
+	if len(cmd.aliases) != 0 {
+		fmt.Fprintln(w, i18n.T("Синонимы:"), strings.Join(cmd.aliases, ", "))
+	}
+	if len(cmd.examples) != 0 {
+		fmt.Fprintln(w, i18n.T("Примеры:"))
+		for _, example := range cmd.examples {
+			fmt.Fprintln(w, "  task-cli "+example)
+		}
+	}
+}
+
+func suggestCommand(name string) (string, bool) {
+	maxDistance := min(suggestMaxDistance, utf8.RuneCountInString(name)/2)
+	best, bestDistance := "", maxDistance+1
+	for _, cmd := range commands {
+		if cmd.hidden {
+			continue
+		}
+		for _, candidate := range append([]string{cmd.name}, cmd.aliases...) {
+			if d := editDistance(strings.ToLower(name), candidate); d < bestDistance {
+				best, bestDistance = candidate, d
+			}
+		}
+	}
+
+	return best, best != ""
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return rows[len(ra)][len(rb)]
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"slices"
+	"strings"
+)
+
+func PrintHistory(entries []model.HistoryEntry, args []string) int {
+	if wantsHelp(args) {
+		cmd, _ := findCommand("history")
+		printCommandHelp(os.Stdout, cmd)
+		return 0
+	}
+	if len(args) > 1 {
+		printError(usageError(i18n.T("history [id]")))
+		return 1
+	}
+
+	id := 0
+	if len(args) == 1 {
+		var err error
+		if id, err = parseId(args[0]); err != nil {
+			printError(err)
+			return 1
+		}
+		entries = slices.DeleteFunc(entries, func(entry model.HistoryEntry) bool {
+			return entry.TaskId != id
+		})
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("История пуста."))
+		return 0
+	}
+
+	for _, entry := range entries {
+		task := entry.After
+		if task == nil {
+			task = entry.Before
+		}
+		description := ""
+		if task != nil {
+			description, _, _ = strings.Cut(task.Description, "\n")
+		}
+
+		fmt.Printf("%s %s [%d] %s\n", formatTimestamp(entry.Time, displayOptions{}), entry.Action, entry.TaskId, description)
+		if entry.Before != nil && entry.After != nil {
+			for _, change := range taskChanges(*entry.Before, *entry.After) {
+				fmt.Println("    " + change)
+			}
+		}
+	}
+
+	return 0
+}
+
+func taskChanges(before, after model.Task) []string {
+	var changes []string
+	change := func(name, old, new string) {
+		if old != new {
+			changes = append(changes, fmt.Sprintf("%s %q → %q", name, old, new))
+		}
+	}
+
+	change(i18n.T("Описание:"), before.Description, after.Description)
+	change(i18n.T("Статус:"), string(before.Status), string(after.Status))
+	change(i18n.T("Приоритет:"), string(before.Priority), string(after.Priority))
+	change(i18n.T("Срок:"), before.DueDate, after.DueDate)
+	change(i18n.T("Теги:"), strings.Join(before.Tags, ", "), strings.Join(after.Tags, ", "))
+	change(i18n.T("Исполнитель:"), before.Assignee, after.Assignee)
+	change(i18n.T("Заметки:"), before.Notes, after.Notes)
+	change(i18n.T("Архив:"), fmt.Sprint(before.Archived), fmt.Sprint(after.Archived))
+	change(i18n.T("Удалено:"), fmt.Sprint(before.Deleted), fmt.Sprint(after.Deleted))
+
+	return changes
+}
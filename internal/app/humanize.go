@@ -0,0 +1,138 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+func humanizeDuration(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		n := int(d / time.Minute)
+		return fmt.Sprintf("%d %s", n, i18n.Plural(n, "минуту", "минуты", "минут"))
+	case d < 24*time.Hour:
+		n := int(d / time.Hour)
+		return fmt.Sprintf("%d %s", n, i18n.Plural(n, "час", "часа", "часов"))
+	case d < 30*24*time.Hour:
+		n := int(d / (24 * time.Hour))
+		return fmt.Sprintf("%d %s", n, i18n.Plural(n, "день", "дня", "дней"))
+	case d < 365*24*time.Hour:
+		n := int(d / (30 * 24 * time.Hour))
+		return fmt.Sprintf("%d %s", n, i18n.Plural(n, "месяц", "месяца", "месяцев"))
+	default:
+		n := int(d / (365 * 24 * time.Hour))
+		return fmt.Sprintf("%d %s", n, i18n.Plural(n, "год", "года", "лет"))
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d < 0 {
+		d = -d
+	}
+
+	units := []struct {
+		size time.Duration
+		name string
+	}{
+		{24 * time.Hour, i18n.T("д")},
+		{time.Hour, i18n.T("ч")},
+		{time.Minute, i18n.T("м")},
+		{time.Second, i18n.T("с")},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		if n := d / unit.size; n > 0 || (len(parts) == 0 && unit.size == time.Second) {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.name))
+			d -= n * unit.size
+		} else if len(parts) != 0 {
+			break
+		}
+		if len(parts) == 2 {
+			break
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	if d > -time.Minute && d < time.Minute {
+		return i18n.T("только что")
+	}
+
+	if d < 0 {
+		return i18n.T("через ") + humanizeDuration(-d)
+	}
+
+	return humanizeDuration(d) + i18n.T(" назад")
+}
+
+type timeFormat struct {
+	layout   string
+	relative bool
+}
+
+var displayFormat = sync.OnceValue(func() timeFormat {
+	return parseTimeFormat(os.Getenv("TASK_CLI_TIME_FORMAT"))
+})
+
+func parseTimeFormat(value string) timeFormat {
+	switch value {
+	case "":
+		return timeFormat{layout: time.RFC3339}
+	case "date":
+		return timeFormat{layout: timeutil.DateLayout}
+	case "datetime":
+		return timeFormat{layout: "2006-01-02 15:04"}
+	case "relative":
+		return timeFormat{layout: time.RFC3339, relative: true}
+	}
+
+	sample := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	if sample.Format(value) == value {
+		fmt.Fprintf(os.Stderr, i18n.T("Предупреждение: неверный формат времени TASK_CLI_TIME_FORMAT %q, используется RFC3339\n"), value)
+		return timeFormat{layout: time.RFC3339}
+	}
+
+	return timeFormat{layout: value}
+}
+
+type displayOptions struct {
+	relative bool
+	utc      bool
+	verbose  bool
+	progress map[int]model.Progress
+}
+
+func formatTimestamp(value string, display displayOptions) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+
+	if display.utc {
+		t = t.UTC()
+	} else {
+		t = t.Local()
+	}
+	format := displayFormat()
+	if format.relative {
+		return relativeTime(t, time.Now())
+	}
+	formatted := t.Format(format.layout)
+
+	if !display.relative {
+		return formatted
+	}
+
+	return fmt.Sprintf("%s (%s)", formatted, relativeTime(t, time.Now()))
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"go-task-cli/internal/i18n"
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	d, h, m, s := i18n.T("д"), i18n.T("ч"), i18n.T("м"), i18n.T("с")
+
+	tests := []struct {
+		name string
+		in   time.Duration
+		want string
+	}{
+		{"zero", 0, "0" + s},
+		{"sub-second", 400 * time.Millisecond, "0" + s},
+		{"sub-minute", 45 * time.Second, "45" + s},
+		{"minutes", 5*time.Minute + 7*time.Second, "5" + m + " 7" + s},
+		{"hour", time.Hour + 23*time.Minute, "1" + h + " 23" + m},
+		{"hour drops seconds", time.Hour + 23*time.Minute + 59*time.Second, "1" + h + " 23" + m},
+		{"whole hours", 2 * time.Hour, "2" + h},
+		{"multi-day", 3*24*time.Hour + 4*time.Hour + 5*time.Minute, "3" + d + " 4" + h},
+		{"negative", -90 * time.Second, "1" + m + " 30" + s},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatDuration(tt.in); got != tt.want {
+				t.Errorf("formatDuration(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const maxIdRange = 10000
+
+func parseIds(args []string) ([]int, error) {
+	var ids []int
+	for _, arg := range args {
+		for part := range strings.SplitSeq(arg, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			from, to, isRange := strings.Cut(part, "-")
+			if !isRange {
+				id, err := parseId(part)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+				continue
+			}
+
+			if from == "" {
+				return nil, fmt.Errorf(i18n.T("идентификатор задачи должен быть положительным: %s"), part)
+			}
+			start, err := strconv.Atoi(from)
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("неверный диапазон %q"), part)
+			}
+			end, err := strconv.Atoi(to)
+			if err != nil || end < start {
+				return nil, fmt.Errorf(i18n.T("неверный диапазон %q"), part)
+			}
+			if start < 1 {
+				return nil, fmt.Errorf(i18n.T("идентификатор задачи должен быть положительным: %s"), part)
+			}
+			if end-start >= maxIdRange {
+				return nil, fmt.Errorf(i18n.T("слишком большой диапазон %q"), part)
+			}
+			for id := start; id <= end; id++ {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.New(i18n.T("не указаны идентификаторы задач"))
+	}
+
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseIds(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []int
+		wantErr bool
+	}{
+		{"range", []string{"1-3"}, []int{1, 2, 3}, false},
+		{"list", []string{"1,2,3"}, []int{1, 2, 3}, false},
+		{"mixed", []string{"1-3,5"}, []int{1, 2, 3, 5}, false},
+		{"mixed args", []string{"7", "1-2,4", "3"}, []int{1, 2, 3, 4, 7}, false},
+		{"duplicates", []string{"1-3,2", "3"}, []int{1, 2, 3}, false},
+		{"single range", []string{"4-4"}, []int{4}, false},
+		{"spaces and empty parts", []string{"1, 2,,3"}, []int{1, 2, 3}, false},
+		{"reversed range", []string{"3-1"}, nil, true},
+		{"open range", []string{"1-"}, nil, true},
+		{"garbage", []string{"a-b"}, nil, true},
+		{"too large", []string{"1-20000"}, nil, true},
+		{"empty", []string{","}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseIds(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseIds(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseIds(%q) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeService struct {
+	TaskService
+}
+
+func TestParseId(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    int
+		wantErr bool
+	}{
+		{"1", 1, false},
+		{"42", 42, false},
+		{"0", 0, true},
+		{"-3", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseId(tt.arg)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseId(%q) error = %v, wantErr %v", tt.arg, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseId(%q) = %d, want %d", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestRejectNonPositiveIds(t *testing.T) {
+	var serv fakeService
+	for _, name := range []string{"update", "delete", "mark-todo", "mark-in-progress", "mark-done"} {
+		cmd, ok := findCommand(name)
+		if !ok {
+			t.Fatalf("command %q not registered", name)
+		}
+		for _, id := range []string{"0", "-3"} {
+			if err := cmd.run(serv, []string{id, "x"}); err == nil {
+				t.Errorf("%s %s: expected error", name, id)
+			}
+		}
+	}
+}
+
+func TestParseIdsRejectsNonPositive(t *testing.T) {
+	for _, arg := range []string{"0", "-3", "0-2", "-3-5", "1,0"} {
+		if ids, err := parseIds([]string{arg}); err == nil {
+			t.Errorf("parseIds(%q) = %v, want error", arg, ids)
+		}
+	}
+	if ids, err := parseIds([]string{"1", "2"}); err != nil || !slices.Equal(ids, []int{1, 2}) {
+		t.Errorf("parseIds(1 2) = %v, %v", ids, err)
+	}
+}
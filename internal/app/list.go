@@ -0,0 +1,439 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	pinMarker       = "★"
+	defaultTopCount = 3
+)
+
+var ListDefaults []string
+
+var statusTitles = map[model.TaskStatus]string{
+	model.StatusTodo:       "TODO",
+	model.StatusInProgress: i18n.T("В процессе"),
+	model.StatusDone:       i18n.T("Выполнено"),
+}
+
+func cmdList(serv TaskService, args []string) error {
+	var filter model.TaskFilter
+	fs := newFlagSet("list")
+	fs.StringVar(&filter.Sort, "sort", "", "")
+	fs.BoolVar(&filter.Reverse, "reverse", false, "")
+	fs.IntVar(&filter.Limit, "limit", 0, "")
+	fs.IntVar(&filter.Offset, "offset", 0, "")
+	asJSON := fs.Bool("json", false, "")
+	asTable := fs.Bool("table", false, "")
+	var display displayOptions
+	fs.BoolVar(&display.relative, "relative", false, "")
+	fs.BoolVar(&display.utc, "utc", false, "")
+	fs.BoolVar(&display.verbose, "verbose", false, "")
+	fs.BoolVar(&display.verbose, "v", false, "")
+	group := fs.Bool("group", false, "")
+	format := fs.String("format", "", "")
+	var quiet bool
+	fs.BoolVar(&quiet, "q", false, "")
+	fs.BoolVar(&quiet, "quiet", false, "")
+	filters := addFilterFlags(fs, &filter)
+	var defaultStatus string
+	var defaultStatuses []bool
+	if len(ListDefaults) != 0 {
+		rest, err := parseFlags(fs, ListDefaults)
+		if err != nil {
+			return fmt.Errorf(i18n.T("ошибка в настройке list файла конфигурации: %v"), err)
+		}
+		if len(rest) != 0 {
+			return fmt.Errorf(i18n.T("в файле конфигурации для list допустимы только флаги, а не %q"), rest[0])
+		}
+		defaultStatus, defaultStatuses = filters.status, slices.Clone(filters.statuses)
+		filters.status = ""
+		clear(filters.statuses)
+	}
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 1 {
+		return usageError(i18n.T("list [статус] [флаги...]"))
+	}
+	if len(args) != 0 {
+		if filters.explicitStatus() {
+			return errors.New(i18n.T("статус нельзя указывать вместе с флагами --status, --todo, --in-progress и --done"))
+		}
+		filters.status = args[0]
+	} else if !filters.explicitStatus() && defaultStatuses != nil {
+		filters.status = defaultStatus
+		copy(filters.statuses, defaultStatuses)
+	}
+	if *group && *asJSON {
+		return errors.New(i18n.T("флаги --group и --json несовместимы"))
+	}
+	if quiet && (*asJSON || *asTable || *group || *format != "") {
+		return errors.New(i18n.T("флаг -q несовместим с --json, --table, --group и --format"))
+	}
+	var tmpl *template.Template
+	if *format != "" {
+		if *asJSON || *asTable || *group {
+			return errors.New(i18n.T("флаг --format несовместим с --json, --table и --group"))
+		}
+		if tmpl, err = template.New("format").Parse(*format); err != nil {
+			return fmt.Errorf(i18n.T("неверный шаблон --format: %v"), err)
+		}
+	}
+	if err := filters.apply(&filter); err != nil {
+		return err
+	}
+
+	tasks, err := serv.ListTasks(filter)
+	if err != nil {
+		return err
+	}
+
+	if quiet {
+		for _, task := range tasks {
+			fmt.Println(task.Id)
+		}
+		return nil
+	}
+	if *asJSON {
+		return printJSON(tasks)
+	}
+	if tmpl != nil {
+		return printTemplate(tmpl, tasks)
+	}
+	if display.progress, err = serv.SubtaskProgress(); err != nil {
+		return err
+	}
+	if *group {
+		printGrouped(tasks, *asTable, display)
+	} else {
+		printTree(tasks, *asTable, display)
+	}
+	printSummary(tasks)
+
+	return nil
+}
+
+func cmdOverdue(serv TaskService, args []string) error {
+	if len(args) != 0 {
+		return usageError("overdue")
+	}
+
+	now := time.Now()
+	tasks, err := serv.OverdueTasks(now)
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("Просроченных задач нет."))
+		return nil
+	}
+
+	fmt.Println(i18n.T("Просроченные задачи:"))
+	for _, task := range tasks {
+		deadline, _ := timeutil.DueDeadline(task.DueDate)
+		fmt.Printf(i18n.T("[%d] %s - срок: %s, просрочено на %s\n"), task.Id, task.Description, task.DueDate, humanizeDuration(now.Sub(deadline)))
+	}
+
+	return nil
+}
+
+func cmdToday(serv TaskService, args []string) error {
+	if len(args) != 0 {
+		return usageError("today")
+	}
+
+	tasks, err := serv.DueTodayTasks(time.Now())
+	if err != nil {
+		return err
+	}
+	printTasks(tasks, displayOptions{})
+
+	return nil
+}
+
+func cmdNext(serv TaskService, args []string) error {
+	if len(args) != 0 {
+		return usageError("next")
+	}
+
+	task, err := serv.NextTask()
+	if err != nil {
+		return err
+	}
+
+	if task == nil {
+		fmt.Fprintln(os.Stderr, i18n.T("Все задачи выполнены, можно отдохнуть!"))
+		return nil
+	}
+	printTask(*task, displayOptions{})
+
+	return nil
+}
+
+func cmdTop(serv TaskService, args []string) error {
+	fs := newFlagSet("top")
+	asJSON := fs.Bool("json", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 1 {
+		return usageError("top [N] [--json]")
+	}
+
+	n := defaultTopCount
+	if len(args) == 1 {
+		if n, err = strconv.Atoi(args[0]); err != nil {
+			return fmt.Errorf(i18n.T("неверное количество задач %q"), args[0])
+		}
+	}
+
+	tasks, err := serv.TopTasks(n)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return printJSON(tasks)
+	}
+	printTasks(tasks, displayOptions{})
+
+	return nil
+}
+
+func cmdSearch(serv TaskService, args []string) error {
+	var opts model.SearchOptions
+	fs := newFlagSet("search")
+	fs.BoolVar(&opts.Exact, "exact", false, "")
+	fs.BoolVar(&opts.Regexp, "regexp", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return usageError(i18n.T("search [--exact|--regexp] <запрос>"))
+	}
+
+	tasks, err := serv.SearchTasks(strings.Join(args, " "), opts)
+	if err != nil {
+		return err
+	}
+	printTasks(tasks, displayOptions{})
+
+	return nil
+}
+
+func cmdCount(serv TaskService, args []string) error {
+	fs := newFlagSet("count")
+	asJSON := fs.Bool("json", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError("count [--json]")
+	}
+
+	counts, err := serv.CountTasks()
+	if err != nil {
+		return err
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	if *asJSON {
+		result := map[string]int{"total": total}
+		for status, count := range counts {
+			result[string(status)] = count
+		}
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf(i18n.T("ошибка сериализации: %v"), err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Println(i18n.T("Всего задач:"), total)
+	for _, status := range model.Statuses {
+		fmt.Printf("%s: %d\n", status, counts[status])
+	}
+
+	return nil
+}
+
+func indentLines(s string, indent string) string {
+	return strings.ReplaceAll(s, "\n", "\n"+indent)
+}
+
+func printJSON(tasks []model.Task) error {
+	if tasks == nil {
+		tasks = []model.Task{}
+	}
+
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка сериализации задач: %v"), err)
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
+
+func printTemplate(tmpl *template.Template, tasks []model.Task) error {
+	for _, task := range tasks {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, task); err != nil {
+			return fmt.Errorf(i18n.T("ошибка выполнения шаблона --format: %v"), err)
+		}
+		fmt.Println(b.String())
+	}
+
+	return nil
+}
+
+func printTasks(tasks []model.Task, display displayOptions) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("Задачи не найдены."))
+		return
+	}
+
+	fmt.Println(i18n.T("Задачи:"))
+	for _, task := range tasks {
+		printTask(task, display)
+	}
+}
+
+func printGrouped(tasks []model.Task, table bool, display displayOptions) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("Задачи не найдены."))
+		return
+	}
+
+	first := true
+	for _, status := range model.Statuses {
+		var group []model.Task
+		for _, task := range tasks {
+			if task.Status == status {
+				group = append(group, task)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		if !first {
+			fmt.Println()
+		}
+		first = false
+
+		title, ok := statusTitles[status]
+		if !ok {
+			title = string(status)
+		}
+		fmt.Printf("%s (%d):\n", colors.status(status, title), len(group))
+		if table {
+			renderTable(group, nil, display)
+			continue
+		}
+		for _, task := range group {
+			printTask(task, display)
+		}
+	}
+}
+
+func printSummary(tasks []model.Task) {
+	if len(tasks) == 0 {
+		return
+	}
+
+	counts := make(map[model.TaskStatus]int)
+	for _, task := range tasks {
+		counts[task.Status]++
+	}
+
+	var parts []string
+	for _, status := range model.Statuses {
+		if counts[status] != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+
+	n := len(tasks)
+	fmt.Printf("%d %s: %s\n", n, i18n.Plural(n, "задача", "задачи", "задач"), strings.Join(parts, ", "))
+}
+
+func progressSuffix(id int, display displayOptions) string {
+	p, ok := display.progress[id]
+	if !ok || p.Total == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(i18n.T(" (%d/%d выполнено)"), p.Done, p.Total)
+}
+
+func printTask(task model.Task, display displayOptions) {
+	printTaskIndented(task, display, "")
+}
+
+func printTaskIndented(task model.Task, display displayOptions, indent string) {
+	line := func(a ...any) {
+		fmt.Print(indent)
+		fmt.Println(a...)
+	}
+
+	if task.Pinned {
+		line("ID:", task.Id, pinMarker)
+	} else {
+		line("ID:", task.Id)
+	}
+	line(i18n.T("Описание:"), indentLines(task.Description, indent+"          ")+progressSuffix(task.Id, display))
+	line(i18n.T("Статус:"), colors.status(task.Status, string(task.Status)))
+	line(i18n.T("Приоритет:"), colors.priority(task.Priority, string(task.Priority)))
+	line(i18n.T("Создано:"), formatTimestamp(task.CreatedAt, display))
+	line(i18n.T("Обновлено:"), formatTimestamp(task.UpdatedAt, display))
+	if task.Status == model.StatusDone && task.CompletedAt != "" {
+		line(i18n.T("Завершено:"), formatTimestamp(task.CompletedAt, display))
+	}
+	if task.DueDate != "" {
+		line(i18n.T("Срок:"), task.DueDate)
+	}
+	if len(task.Tags) != 0 {
+		line(i18n.T("Теги:"), strings.Join(task.Tags, ", "))
+	}
+	if task.Assignee != "" {
+		line(i18n.T("Исполнитель:"), task.Assignee)
+	}
+	if display.verbose && task.Notes != "" {
+		line(i18n.T("Заметки:"), indentLines(task.Notes, indent+"         "))
+	}
+	if task.ParentId != 0 {
+		line(i18n.T("Родитель:"), task.ParentId)
+	}
+	if len(task.DependsOn) != 0 {
+		line(i18n.T("Зависит от:"), model.JoinIds(task.DependsOn))
+	}
+	if task.Deleted {
+		line(i18n.T("Удалено:"), formatTimestamp(task.DeletedAt, display))
+	}
+	line("-------------------")
+}
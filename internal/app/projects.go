@@ -0,0 +1,30 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"os"
+)
+
+func PrintProjects(projects []string, current string, args []string) int {
+	if wantsHelp(args) {
+		cmd, _ := findCommand("projects")
+		printCommandHelp(os.Stdout, cmd)
+		return 0
+	}
+	if len(args) != 0 {
+		printError(usageError("projects"))
+		return 1
+	}
+
+	fmt.Println(i18n.T("Проекты:"))
+	for _, project := range projects {
+		if project == current {
+			fmt.Println("* " + project)
+		} else {
+			fmt.Println("  " + project)
+		}
+	}
+
+	return 0
+}
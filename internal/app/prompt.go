@@ -0,0 +1,47 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+var stdin = bufio.NewReader(os.Stdin)
+
+func confirm(prompt string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+
+	answer, err := stdin.ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(os.Stderr)
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes", "д", "да":
+		return true
+	default:
+		return false
+	}
+}
+
+func confirmUnlessForced(prompt string, force bool) (bool, error) {
+	if force {
+		return true, nil
+	}
+	if !stdinIsTerminal() {
+		return false, errors.New(i18n.T("нет терминала для подтверждения, используйте --force"))
+	}
+
+	return confirm(prompt), nil
+}
+
+func stdinIsTerminal() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
@@ -0,0 +1,139 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"strings"
+)
+
+type command struct {
+	name     string
+	aliases  []string
+	args     string
+	summary  string
+	examples []string
+	takesId  bool
+	hidden   bool
+	run      func(serv TaskService, args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "add", args: i18n.T("[-q] [--parent <id>] [--no-dup] <описание|-> | --from-file <файл|->"), summary: i18n.T("Добавить новую задачу (или подзадачу)"), examples: []string{i18n.T("add \"Купить молоко\""), i18n.T("add --parent 3 Позвонить поставщику"), "add --from-file tasks.txt"}, run: cmdAdd},
+		{name: "clone", args: "<id>", summary: i18n.T("Создать копию задачи в статусе todo"), takesId: true, run: cmdClone},
+		{name: "update", aliases: []string{"rename"}, args: i18n.T("<id> <описание>"), summary: i18n.T("Обновить задачу"), examples: []string{i18n.T("update 2 \"Купить хлеб\"")}, takesId: true, run: cmdUpdate},
+		{name: "edit", args: "<id>", summary: i18n.T("Изменить описание и заметки задачи в редакторе $EDITOR"), takesId: true, run: cmdEdit},
+		{name: "append", args: i18n.T("<id> <текст>"), summary: i18n.T("Добавить строку к описанию задачи"), takesId: true, run: cmdAppend},
+		{name: "note", args: i18n.T("<id> [текст|-]"), summary: i18n.T("Задать заметки задачи (без текста очистить, - прочитать из stdin)"), takesId: true, run: cmdNote},
+		{name: "delete", aliases: []string{"rm"}, args: i18n.T("[--hard] [--cascade] [--force|-y] <id|диапазон>[,...]"), summary: i18n.T("Переместить задачи в корзину (например: 1-3,5), с --hard удалить навсегда, с --cascade вместе с подзадачами"), examples: []string{"delete 4", "delete --hard -y 1-3,5", "delete --cascade 2"}, takesId: true, run: cmdDelete},
+		{name: "trash", summary: i18n.T("Список задач в корзине"), run: cmdTrash},
+		{name: "restore", args: "<id>", summary: i18n.T("Восстановить задачу из корзины"), run: cmdRestore},
+		{name: "purge", args: i18n.T("[--done [--force|-y]] [--older-than <срок>]"), summary: i18n.T("Окончательно удалить задачи из корзины (с --done выполненные задачи)"), examples: []string{"purge --older-than 30d", "purge --done -y --older-than 2w"}, run: cmdPurge},
+		{name: "clear", args: "[--force|-f|-y]", summary: i18n.T("Удалить все задачи"), run: cmdClear},
+		{name: "undo", summary: i18n.T("Отменить последнее изменение"), run: cmdUndo},
+		{name: "mark-todo", args: i18n.T("<id> [id...] | --all | --status <статус>"), summary: i18n.T("Отметить задачи как TODO"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdMark(serv, "mark-todo", args, model.StatusTodo, func(id int) {
+				fmt.Fprintf(os.Stderr, i18n.T("Задача пометлена как TODO (ID: %d)\n"), id)
+			})
+		}},
+		{name: "mark-in-progress", args: i18n.T("<id> [id...] | --all | --status <статус>"), summary: i18n.T("Отметить задачи как в процессе"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdMark(serv, "mark-in-progress", args, model.StatusInProgress, func(id int) {
+				fmt.Fprintf(os.Stderr, i18n.T("Задача пометлена как в процессе (ID: %d)\n"), id)
+			})
+		}},
+		{name: "mark-done", aliases: []string{"done"}, args: i18n.T("[--force] <id> [id...] | --all | --status <статус>"), summary: i18n.T("Отметить задачи как выполненные (--force игнорирует незавершенные зависимости)"), examples: []string{"mark-done 1 2 3", "mark-done --status in-progress"}, takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdMark(serv, "mark-done", args, model.StatusDone, func(id int) {
+				fmt.Fprintf(os.Stderr, i18n.T("Задача пометлена как выполненная (ID: %d)\n"), id)
+			})
+		}},
+		{name: "mark", args: i18n.T("<id> [id...] <статус>"), summary: i18n.T("Перевести задачи в любой допустимый статус"), takesId: true, run: cmdMarkStatus},
+		{name: "reopen", args: "<id>", summary: i18n.T("Вернуть выполненную или начатую задачу в статус todo"), takesId: true, run: cmdReopen},
+		{name: "start", args: "<id>", summary: i18n.T("Запустить таймер задачи"), takesId: true, run: cmdStart},
+		{name: "stop", args: "<id>", summary: i18n.T("Остановить таймер задачи"), takesId: true, run: cmdStop},
+		{name: "time", args: "<id>", summary: i18n.T("Суммарное затраченное на задачу время"), takesId: true, run: cmdTime},
+		{name: "depend", args: i18n.T("<id> <id зависимости>"), summary: i18n.T("Добавить зависимость задачи от другой задачи"), takesId: true, run: cmdDepend},
+		{name: "move", args: i18n.T("<id> <позиция>"), summary: i18n.T("Переместить задачу на позицию в списке"), takesId: true, run: cmdMove},
+		{name: "swap", args: "<id> <id>", summary: i18n.T("Поменять две задачи местами в списке"), takesId: true, run: cmdSwap},
+		{name: "merge", args: i18n.T("<id источника> <id назначения>"), summary: i18n.T("Перенести описание, заметки и теги задачи в другую задачу и удалить ее"), examples: []string{"merge 7 3"}, takesId: true, run: cmdMerge},
+		{name: "set", args: i18n.T("[--force] <id> <поле>=<значение> [...]"), summary: i18n.T("Изменить поля задачи: description, status, priority, due, tags (через запятую)"), examples: []string{"set 3 priority=high due=tomorrow", "set 3 tags=work,urgent", "set 3 due="}, takesId: true, run: cmdSet},
+		{name: "due", args: i18n.T("<id> <дата>"), summary: i18n.T("Установить срок задачи (ГГГГ-ММ-ДД, RFC3339, today, tomorrow, next <день недели>, +Nd)"), examples: []string{"due 1 2026-12-31", "due 2 tomorrow", "due 3 +3d"}, takesId: true, run: cmdDue},
+		{name: "snooze", args: i18n.T("<id> <срок>"), summary: i18n.T("Отложить срок задачи на указанный срок (например, 2d, 1w или 3h)"), examples: []string{"snooze 1 2d", "snooze 2 1w", "snooze 3 3h"}, takesId: true, run: cmdSnooze},
+		{name: "priority", args: "<id> <low|medium|high>", summary: i18n.T("Установить приоритет задачи"), takesId: true, run: cmdPriority},
+		{name: "assign", args: i18n.T("<id> <имя>"), summary: i18n.T("Назначить исполнителя задачи"), takesId: true, run: cmdAssign},
+		{name: "unassign", args: "<id>", summary: i18n.T("Снять исполнителя задачи"), takesId: true, run: cmdUnassign},
+		{name: "tag", args: i18n.T("<id> <тег>"), summary: i18n.T("Добавить тег задаче"), takesId: true, run: cmdTag},
+		{name: "untag", args: i18n.T("<id> <тег>"), summary: i18n.T("Убрать тег у задачи"), takesId: true, run: cmdUntag},
+		{name: "archive", args: "<id>", summary: i18n.T("Переместить задачу в архив"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdArchive(serv, "archive", args)
+		}},
+		{name: "unarchive", args: "<id>", summary: i18n.T("Вернуть задачу из архива"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdArchive(serv, "unarchive", args)
+		}},
+		{name: "pin", args: "<id>", summary: i18n.T("Закрепить задачу в начале списка"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdPin(serv, "pin", args)
+		}},
+		{name: "unpin", args: "<id>", summary: i18n.T("Открепить задачу"), takesId: true, run: func(serv TaskService, args []string) error {
+			return cmdPin(serv, "unpin", args)
+		}},
+		{name: "list", aliases: []string{"ls"}, args: i18n.T("[статус] [--status <статус>] [--todo] [--in-progress] [--done] [--tag <тег>] [--assignee <имя|none>] [--archived] [--blocked] [--pinned] [--since <дата|срок>] [--sort <ключ>] [--reverse] [--limit N] [--offset N] [--json|--table|--format <шаблон>] [--relative] [--utc] [--verbose] [--group] [-q]"), summary: i18n.T("Список всех задач или задач по статусу (todo, in-progress, done), тегу и исполнителю"), examples: []string{"list todo", "list --todo --in-progress --sort created", "list --tag work --reverse", "list --format '{{.Id}} {{.Description}}'"}, run: cmdList},
+		{name: "overdue", summary: i18n.T("Список просроченных задач"), run: cmdOverdue},
+		{name: "today", summary: i18n.T("Список задач со сроком на сегодня"), run: cmdToday},
+		{name: "next", summary: i18n.T("Самая важная незавершенная задача"), run: cmdNext},
+		{name: "top", args: "[N] [--json]", summary: i18n.T("N самых важных незавершенных задач (по умолчанию 3)"), examples: []string{"top", "top 5 --json"}, run: cmdTop},
+		{name: "search", args: i18n.T("[--exact|--regexp] <запрос>"), summary: i18n.T("Поиск задач по описанию без учета регистра, по точному совпадению или регулярному выражению"), examples: []string{i18n.T("search молоко"), "search --regexp \"^fix\""}, run: cmdSearch},
+		{name: "count", args: "[--json]", summary: i18n.T("Количество задач всего и по статусам"), run: cmdCount},
+		{name: "stats", args: "[--json]", summary: i18n.T("Статистика выполнения задач"), run: cmdStats},
+		{name: "export", args: i18n.T("<csv|md|json|ics|todotxt> [--status <статус>] [--todo] [--in-progress] [--done] [--tag <тег>] [--assignee <имя|none>] [--archived] [--blocked] [--pinned] [--since <дата|срок>] [файл]"), summary: i18n.T("Экспорт задач (всех или по фильтрам list) в CSV, Markdown, JSON или todo.txt, задач со сроком в календарь ICS (по умолчанию в stdout)"), examples: []string{"export csv tasks.csv", "export json --done --since 7d", "export ics > tasks.ics"}, run: cmdExport},
+		{name: "import", args: i18n.T("<csv|todotxt> <файл>"), summary: i18n.T("Импорт задач из CSV или todo.txt с назначением новых ID"), run: cmdImport},
+		{name: "doctor", args: "[--fix]", summary: i18n.T("Проверить файл задач на ошибки (с --fix исправить их)"), run: cmdDoctor},
+		{name: "verify", args: "[--rewrite]", summary: i18n.T("Проверить, что файл задач в каноническом формате (с --rewrite переписать его)"), run: cmdVerify},
+		{name: "completion", args: "<bash|zsh|fish>", summary: i18n.T("Скрипт автодополнения для командной оболочки"), run: cmdCompletion},
+		{name: "projects", summary: i18n.T("Список проектов в каталоге хранилища"), run: func(serv TaskService, args []string) error {
+			return errors.New(i18n.T("команда projects недоступна в интерактивном режиме"))
+		}},
+		{name: "history", args: "[id]", summary: i18n.T("Журнал изменений задач (с id только одной задачи)"), run: func(serv TaskService, args []string) error {
+			return errors.New(i18n.T("команда history недоступна в интерактивном режиме"))
+		}},
+		{name: "watch", args: i18n.T("[--interval <длительность>] [статус]"), summary: i18n.T("Список задач во весь экран с обновлением при изменении файла"), examples: []string{"watch", "watch --interval 5s todo"}, run: func(serv TaskService, args []string) error {
+			return errors.New(i18n.T("команда watch недоступна в интерактивном режиме"))
+		}},
+		{name: "shell", aliases: []string{"-i"}, summary: i18n.T("Интерактивный режим"), run: func(serv TaskService, args []string) error {
+			return errors.New(i18n.T("интерактивный режим уже запущен"))
+		}},
+		{name: "help", aliases: []string{"--help", "-h"}, args: i18n.T("[команда]"), summary: i18n.T("Справка по командам или по одной команде"), examples: []string{"help", "help list"}, run: cmdHelp},
+		{name: "version", aliases: []string{"--version"}, summary: i18n.T("Версия программы"), run: func(serv TaskService, args []string) error {
+			printVersion()
+			return nil
+		}},
+		{name: "__complete-ids", hidden: true, run: cmdCompleteIds},
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+		for _, alias := range cmd.aliases {
+			if alias == name {
+				return cmd, true
+			}
+		}
+	}
+
+	return command{}, false
+}
+
+func (c command) usageLine() string {
+	names := strings.Join(append([]string{c.name}, c.aliases...), ", ")
+	if c.args != "" {
+		names += " " + c.args
+	}
+
+	return names + " - " + c.summary
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"io"
+	"os"
+	"strings"
+)
+
+func Shell(serv TaskService, save func() error) int {
+	fmt.Fprintln(os.Stderr, i18n.T("Интерактивный режим task-cli. Введите help для списка команд, exit для выхода"))
+
+	for {
+		fmt.Fprint(os.Stderr, "task> ")
+		line, err := stdin.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			fmt.Fprintf(os.Stderr, i18n.T("Ошибка чтения ввода: %v\n"), err)
+			return 1
+		}
+		if err != nil && line == "" {
+			fmt.Fprintln(os.Stderr)
+			return saveShell(save)
+		}
+
+		args, parseErr := splitArgs(line)
+		switch {
+		case parseErr != nil:
+			printError(parseErr)
+		case len(args) == 0:
+		case args[0] == "exit" || args[0] == "quit":
+			return saveShell(save)
+		case args[0] == "help" && len(args) == 1:
+			printUsage(os.Stderr)
+			fmt.Fprintln(os.Stderr, i18n.T("Команды интерактивного режима:"))
+			fmt.Fprintln(os.Stderr, i18n.T("  save - Сохранить изменения"))
+			fmt.Fprintln(os.Stderr, i18n.T("  exit, quit - Сохранить изменения и выйти"))
+		case args[0] == "save":
+			if err := save(); err != nil {
+				fmt.Fprintf(os.Stderr, i18n.T("Ошибка сохранения: %v\n"), err)
+			} else {
+				fmt.Fprintln(os.Stderr, i18n.T("Изменения сохранены"))
+			}
+		case args[0] == "shell" || args[0] == "-i":
+			fmt.Fprintln(os.Stderr, i18n.T("Ошибка: интерактивный режим уже запущен"))
+		default:
+			printError(runCommand(serv, args[0], args[1:]))
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr)
+			return saveShell(save)
+		}
+	}
+}
+
+func saveShell(save func() error) int {
+	if err := save(); err != nil {
+		fmt.Fprintf(os.Stderr, i18n.T("Ошибка сохранения: %v\n"), err)
+		return 1
+	}
+
+	return 0
+}
+
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.New(i18n.T("незакрытая кавычка"))
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+)
+
+func cmdStats(serv TaskService, args []string) error {
+	fs := newFlagSet("stats")
+	asJSON := fs.Bool("json", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError("stats [--json]")
+	}
+
+	stats, err := serv.Stats()
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(struct {
+			model.TaskStats
+			AverageCompletionSeconds *int64 `json:"average_completion_seconds"`
+		}{stats, averageSeconds(stats)}, "", "  ")
+		if err != nil {
+			return fmt.Errorf(i18n.T("ошибка сериализации: %v"), err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Println(i18n.T("Всего задач:"), stats.Total)
+	fmt.Printf(i18n.T("Выполнено: %.1f%%\n"), stats.DonePercent)
+	for _, status := range model.Statuses {
+		fmt.Printf("%s: %d\n", status, stats.ByStatus[status])
+	}
+	if stats.CompletedTimed != 0 {
+		fmt.Println(i18n.T("Среднее время выполнения:"), formatDuration(stats.AverageCompletion))
+	} else {
+		fmt.Println(i18n.T("Среднее время выполнения: нет данных"))
+	}
+
+	return nil
+}
+
+func averageSeconds(stats model.TaskStats) *int64 {
+	if stats.CompletedTimed == 0 {
+		return nil
+	}
+
+	seconds := int64(stats.AverageCompletion.Seconds())
+	return &seconds
+}
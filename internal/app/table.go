@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxDescriptionWidth = 50
+
+func renderTable(tasks []model.Task, depths []int, display displayOptions) {
+	rows := [][]string{{"ID", i18n.T("Статус"), i18n.T("Описание"), i18n.T("Обновлено")}}
+	for i, task := range tasks {
+		description := truncate(strings.Join(strings.Fields(task.Description), " "), maxDescriptionWidth) + progressSuffix(task.Id, display)
+		if depths != nil && depths[i] > 0 {
+			description = strings.Repeat("  ", depths[i]-1) + "└ " + description
+		}
+
+		id := strconv.Itoa(task.Id)
+		if task.Pinned {
+			id += " " + pinMarker
+		}
+
+		rows = append(rows, []string{
+			id,
+			string(task.Status),
+			description,
+			formatTimestamp(task.UpdatedAt, display),
+		})
+	}
+
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	for n, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = pad(cell, widths[i])
+		}
+		if n > 0 {
+			cells[1] = colors.status(tasks[n-1].Status, cells[1])
+		}
+		fmt.Println(strings.TrimRight(strings.Join(cells, "  "), " "))
+	}
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+
+	runes := []rune(s)
+	return string(runes[:width-1]) + "…"
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-utf8.RuneCountInString(s))
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"os"
+	"time"
+)
+
+func cmdStart(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("start <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.StartTimer(id); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Таймер запущен (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdStop(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("stop <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	elapsed, err := serv.StopTimer(id)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Таймер остановлен, прошло %s (ID: %d)\n"), formatDuration(elapsed), id)
+
+	return nil
+}
+
+func cmdTime(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("time <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	total, running, err := serv.TrackedTime(id, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(i18n.T("Затрачено времени: %s\n"), formatDuration(total))
+	if running {
+		fmt.Println(i18n.T("Таймер запущен"))
+	}
+
+	return nil
+}
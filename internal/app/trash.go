@@ -0,0 +1,88 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/timeutil"
+	"os"
+	"time"
+)
+
+func cmdTrash(serv TaskService, args []string) error {
+	if len(args) != 0 {
+		return usageError("trash")
+	}
+
+	tasks, err := serv.TrashTasks()
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("Корзина пуста."))
+		return nil
+	}
+	printTasks(tasks, displayOptions{})
+
+	return nil
+}
+
+func cmdRestore(serv TaskService, args []string) error {
+	if len(args) != 1 {
+		return usageError("restore <id>")
+	}
+
+	id, err := parseId(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := serv.RestoreTask(id); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Задача восстановлена из корзины (ID: %d)\n"), id)
+
+	return nil
+}
+
+func cmdPurge(serv TaskService, args []string) error {
+	fs := newFlagSet("purge")
+	olderThan := fs.String("older-than", "0", "")
+	done := fs.Bool("done", false, "")
+	force := fs.Bool("force", false, "")
+	fs.BoolVar(force, "y", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError(i18n.T("purge [--done [--force|-y]] [--older-than <срок>]"))
+	}
+
+	age, err := timeutil.ParseAge(*olderThan)
+	if err != nil {
+		return err
+	}
+	before := time.Now().Add(-age)
+
+	purge := serv.PurgeTrash
+	if *done {
+		ok, err := confirmUnlessForced(i18n.T("Окончательно удалить выполненные задачи?"), *force)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(os.Stderr, i18n.T("Отменено"))
+			return nil
+		}
+		purge = serv.PurgeDone
+	}
+
+	count, err := purge(before)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, i18n.T("Окончательно удалено задач: %d\n"), count)
+
+	return nil
+}
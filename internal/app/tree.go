@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"strings"
+)
+
+func printTree(tasks []model.Task, table bool, display displayOptions) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("Задачи не найдены."))
+		return
+	}
+
+	ordered, depths := treeOrder(tasks)
+	if table {
+		renderTable(ordered, depths, display)
+		return
+	}
+
+	fmt.Println(i18n.T("Задачи:"))
+	for i, task := range ordered {
+		printTaskIndented(task, display, strings.Repeat("    ", depths[i]))
+	}
+}
+
+func treeOrder(tasks []model.Task) ([]model.Task, []int) {
+	present := make(map[int]bool, len(tasks))
+	for _, task := range tasks {
+		present[task.Id] = true
+	}
+
+	children := make(map[int][]model.Task)
+	var roots []model.Task
+	for _, task := range tasks {
+		if task.ParentId != 0 && task.ParentId != task.Id && present[task.ParentId] {
+			children[task.ParentId] = append(children[task.ParentId], task)
+		} else {
+			roots = append(roots, task)
+		}
+	}
+
+	ordered := make([]model.Task, 0, len(tasks))
+	depths := make([]int, 0, len(tasks))
+	visited := make(map[int]bool, len(tasks))
+	var walk func(task model.Task, depth int)
+	walk = func(task model.Task, depth int) {
+		if visited[task.Id] {
+			return
+		}
+		visited[task.Id] = true
+
+		ordered = append(ordered, task)
+		depths = append(depths, depth)
+		for _, child := range children[task.Id] {
+			walk(child, depth+1)
+		}
+	}
+
+	for _, task := range roots {
+		walk(task, 0)
+	}
+	for _, task := range tasks {
+		walk(task, 0)
+	}
+
+	return ordered, depths
+}
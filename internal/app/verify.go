@@ -0,0 +1,35 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+)
+
+func cmdVerify(serv TaskService, args []string) error {
+	fs := newFlagSet("verify")
+	rewrite := fs.Bool("rewrite", false, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return usageError("verify [--rewrite]")
+	}
+
+	canonical, err := serv.VerifyFormat(*rewrite)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case canonical:
+		fmt.Println(i18n.T("Файл задач в каноническом формате"))
+	case *rewrite:
+		fmt.Println(i18n.T("Файл задач переписан в каноническом формате"))
+	default:
+		return errors.New(i18n.T("файл задач не в каноническом формате, запустите verify --rewrite для исправления"))
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package app
+
+import "fmt"
+
+var (
+	version = "dev"
+	commit  = ""
+)
+
+func printVersion() {
+	if commit != "" {
+		fmt.Printf("task-cli %s (%s)\n", version, commit)
+		return
+	}
+
+	fmt.Printf("task-cli %s\n", version)
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	ansiAltScreen     = "\033[?1049h"
+	ansiMainScreen    = "\033[?1049l"
+	ansiHideCursor    = "\033[?25l"
+	ansiShowCursor    = "\033[?25h"
+	ansiClearScreen   = "\033[H\033[2J"
+	watchDefaultDelay = time.Second
+)
+
+func Watch(serv TaskService, args []string) int {
+	if wantsHelp(args) {
+		cmd, _ := findCommand("watch")
+		printCommandHelp(os.Stdout, cmd)
+		return 0
+	}
+	fs := newFlagSet("watch")
+	interval := fs.Duration("interval", watchDefaultDelay, "")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		printError(err)
+		return 1
+	}
+	if len(args) > 1 || *interval <= 0 {
+		printError(usageError(i18n.T("watch [--interval <длительность>] [статус]")))
+		return 1
+	}
+
+	var filter model.TaskFilter
+	if len(args) != 0 {
+		filter.Status = model.TaskStatus(args[0])
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	fmt.Print(ansiAltScreen + ansiHideCursor)
+	defer fmt.Print(ansiShowCursor + ansiMainScreen)
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	shown := ""
+	for {
+		tasks, err := serv.ListTasks(filter)
+		if state := fmt.Sprint(tasks, err); state != shown {
+			shown = state
+			renderWatch(tasks, err)
+		}
+
+		select {
+		case <-signals:
+			return 0
+		case <-ticker.C:
+		}
+	}
+}
+
+func renderWatch(tasks []model.Task, err error) {
+	fmt.Print(ansiClearScreen)
+	fmt.Printf(i18n.T("Обновлено в %s, Ctrl+C для выхода\n\n"), time.Now().Format("15:04:05"))
+
+	if err != nil {
+		fmt.Printf(i18n.T("Ошибка: %v\n"), err)
+		return
+	}
+
+	printTree(tasks, true, displayOptions{})
+	printSummary(tasks)
+}
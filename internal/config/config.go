@@ -2,22 +2,104 @@ package config
 
 import (
 	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
 	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	BackendJSON   = "json"
+	BackendSQLite = "sqlite"
 )
 
 type Config struct {
-	TaskFile string
+	TaskFile     string
+	BaseFile     string
+	Project      string
+	Backend      string
+	Statuses     []model.TaskStatus
+	ListDefaults []string
+	DryRun       bool
+	Compact      bool
 }
 
-func InitConfig() (*Config, error) {
+func InitConfig(args []string) (*Config, []string, error) {
 	var config Config
-	config.TaskFile = envOrDefault("TASK_FILE", "tasks.json")
+	config.TaskFile = envOrDefault("TASK_CLI_FILE", os.Getenv("TASK_FILE"))
+	config.Backend = envOrDefault("TASK_CLI_BACKEND", BackendJSON)
+	config.Project = envOrDefault("TASK_CLI_PROJECT", DefaultProject)
+	config.Compact, _ = strconv.ParseBool(os.Getenv("TASK_CLI_COMPACT"))
+
+	if err := loadFile(&config); err != nil {
+		return nil, nil, err
+	}
+
+	args, err := parseGlobalFlags(&config, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch config.Backend {
+	case BackendJSON:
+		if config.TaskFile == "" {
+			config.TaskFile = "tasks.json"
+		}
+	case BackendSQLite:
+		if config.TaskFile == "" {
+			config.TaskFile = "tasks.db"
+		}
+	default:
+		return nil, nil, fmt.Errorf(i18n.T("неизвестное хранилище %q (допустимо: %s, %s)"), config.Backend, BackendJSON, BackendSQLite)
+	}
+
+	config.BaseFile = config.TaskFile
+	config.TaskFile, err = projectFile(config.BaseFile, config.Project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &config, args, nil
+}
+
+func parseGlobalFlags(config *Config, args []string) ([]string, error) {
+	targets := map[string]*string{
+		"file":    &config.TaskFile,
+		"backend": &config.Backend,
+		"project": &config.Project,
+	}
+	switches := map[string]*bool{
+		"dry-run": &config.DryRun,
+		"compact": &config.Compact,
+	}
+
+	for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(args[0], "--"), "=")
+		if flag, ok := switches[name]; ok && !hasValue {
+			*flag = true
+			args = args[1:]
+			continue
+		}
+
+		target, ok := targets[name]
+		if !ok {
+			return args, nil
+		}
 
-	if config.TaskFile == "" {
-		return nil, fmt.Errorf("TASK_FILE не указан")
+		if hasValue {
+			args = args[1:]
+		} else {
+			if len(args) < 2 {
+				return nil, fmt.Errorf(i18n.T("флаг --%s требует значение"), name)
+			}
+			value = args[1]
+			args = args[2:]
+		}
+		*target = value
 	}
 
-	return &config, nil
+	return args, nil
 }
 
 func envOrDefault(varName string, defaultValue string) string {
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/repository"
+	"go-task-cli/internal/service"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func setTestEnv(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configFile, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TASK_CLI_CONFIG", configFile)
+	for _, name := range []string{"TASK_CLI_FILE", "TASK_FILE", "TASK_CLI_BACKEND", "TASK_CLI_PROJECT", "TASK_CLI_COMPACT"} {
+		t.Setenv(name, "")
+	}
+	return dir
+}
+
+func TestTaskFileFromEnv(t *testing.T) {
+	dir := setTestEnv(t)
+	file := filepath.Join(dir, "custom.json")
+	t.Setenv("TASK_CLI_FILE", file)
+
+	cfg, _, err := InitConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.TaskFile != file {
+		t.Fatalf("TaskFile = %q, want %q", cfg.TaskFile, file)
+	}
+
+	serv := service.NewTaskService(repository.NewTaskRepository(cfg.TaskFile, cfg.Compact, func(error) {}))
+	if _, err := serv.AddTask("from env", 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Fatalf("tasks file not written to %s: %v", file, err)
+	}
+
+	tasks, err := service.NewTaskService(repository.NewTaskRepository(file, false, func(error) {})).ListTasks(model.TaskFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].Description != "from env" {
+		t.Errorf("ListTasks = %+v, want the added task", tasks)
+	}
+}
+
+func TestTaskFileDefault(t *testing.T) {
+	setTestEnv(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"json", nil, "tasks.json"},
+		{"sqlite", []string{"--backend", "sqlite"}, "tasks.db"},
+		{"flag", []string{"--file=other.json", "list"}, "other.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _, err := InitConfig(tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.TaskFile != tt.want {
+				t.Errorf("TaskFile = %q, want %q", cfg.TaskFile, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomStatusesKeepBuiltins(t *testing.T) {
+	setTestEnv(t)
+	configFile := os.Getenv("TASK_CLI_CONFIG")
+
+	if err := os.WriteFile(configFile, []byte(`{"statuses":["review","blocked"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := InitConfig(nil); err == nil {
+		t.Fatal("InitConfig: expected error for config without built-in statuses")
+	}
+
+	if err := os.WriteFile(configFile, []byte(`{"statuses":["todo","in-progress","review","done"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, err := InitConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := model.Statuses
+	t.Cleanup(func() { model.Statuses = saved })
+	model.Statuses = cfg.Statuses
+
+	serv := service.NewTaskService(repository.NewMemoryTaskRepository(nil))
+	for _, desc := range []string{"first", "second"} {
+		if _, err := serv.AddTask(desc, 0); err != nil {
+			t.Fatalf("AddTask: %v", err)
+		}
+	}
+	if _, err := serv.MarkTasks([]int{1}, "review", false); err != nil {
+		t.Fatalf("MarkTasks(review): %v", err)
+	}
+	if _, err := serv.MarkTasks([]int{2}, model.StatusDone, false); err != nil {
+		t.Fatalf("MarkTasks(done): %v", err)
+	}
+
+	tasks, err := serv.ListTasks(model.TaskFilter{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].Status != "review" || tasks[1].Status != model.StatusDone {
+		t.Errorf("ListTasks = %+v", tasks)
+	}
+}
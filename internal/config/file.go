@@ -0,0 +1,72 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+type fileConfig struct {
+	Statuses []string `json:"statuses"`
+	List     []string `json:"list"`
+}
+
+func loadFile(config *Config) error {
+	path, explicit := configPath()
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка чтения файла конфигурации: %v"), err)
+	}
+
+	var file fileConfig
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf(i18n.T("ошибка парсинга файла конфигурации %s: %v"), path, err)
+	}
+
+	for _, name := range file.Statuses {
+		status := model.TaskStatus(strings.TrimSpace(name))
+		if status == "" || strings.ContainsAny(string(status), " \t") {
+			return fmt.Errorf(i18n.T("неверный статус %q в файле конфигурации"), name)
+		}
+		if slices.Contains(config.Statuses, status) {
+			return fmt.Errorf(i18n.T("статус %q указан в файле конфигурации несколько раз"), status)
+		}
+		config.Statuses = append(config.Statuses, status)
+	}
+	if len(config.Statuses) != 0 {
+		for _, status := range model.BuiltinStatuses {
+			if !slices.Contains(config.Statuses, status) {
+				return fmt.Errorf(i18n.T("в файле конфигурации не указан встроенный статус %q"), status)
+			}
+		}
+	}
+	config.ListDefaults = file.List
+
+	return nil
+}
+
+func configPath() (string, bool) {
+	if path := os.Getenv("TASK_CLI_CONFIG"); path != "" {
+		return path, true
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", false
+	}
+
+	return filepath.Join(dir, "task-cli", "config.json"), false
+}
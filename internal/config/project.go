@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const DefaultProject = "default"
+
+var projectNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func projectFile(baseFile string, project string) (string, error) {
+	if project == "" || project == DefaultProject {
+		return baseFile, nil
+	}
+	if !projectNamePattern.MatchString(project) {
+		return "", fmt.Errorf(i18n.T("неверное имя проекта %q (допустимы латинские буквы, цифры, _ и -)"), project)
+	}
+
+	ext := filepath.Ext(baseFile)
+	return strings.TrimSuffix(baseFile, ext) + "-" + project + ext, nil
+}
+
+func ListProjects(config *Config) ([]string, error) {
+	ext := filepath.Ext(config.BaseFile)
+	prefix := strings.TrimSuffix(config.BaseFile, ext) + "-"
+
+	matches, err := filepath.Glob(escapeGlob(prefix) + "*" + escapeGlob(ext))
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка поиска проектов: %v"), err)
+	}
+
+	projects := []string{DefaultProject}
+	for _, match := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(match, prefix), ext)
+		if projectNamePattern.MatchString(name) && name != DefaultProject {
+			projects = append(projects, name)
+		}
+	}
+	slices.Sort(projects[1:])
+
+	return projects, nil
+}
+
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`*?[\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
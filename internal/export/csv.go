@@ -0,0 +1,93 @@
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{"id", "description", "status", "createdAt", "updatedAt"}
+
+func WriteCSV(w io.Writer, tasks []model.Task) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи CSV: %v"), err)
+	}
+
+	for _, task := range tasks {
+		record := []string{
+			strconv.Itoa(task.Id),
+			task.Description,
+			string(task.Status),
+			task.CreatedAt,
+			task.UpdatedAt,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf(i18n.T("ошибка записи CSV: %v"), err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи CSV: %v"), err)
+	}
+
+	return nil
+}
+
+func ReadCSV(r io.Reader) ([]model.Task, []error, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf(i18n.T("ошибка чтения CSV: %v"), err)
+	}
+	if !slices.Equal(header, csvHeader) {
+		return nil, nil, fmt.Errorf(i18n.T("неверный заголовок CSV: ожидается %v"), csvHeader)
+	}
+
+	var tasks []model.Task
+	var skipped []error
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			skipped = append(skipped, fmt.Errorf(i18n.T("строка %d: неверное количество полей"), parseErr.StartLine))
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf(i18n.T("ошибка чтения CSV: %v"), err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if strings.TrimSpace(record[1]) == "" {
+			skipped = append(skipped, fmt.Errorf(i18n.T("строка %d: пустое описание"), line))
+			continue
+		}
+		status := model.TaskStatus(record[2])
+		if !model.IsValidStatus(status) {
+			skipped = append(skipped, fmt.Errorf(i18n.T("строка %d: неверный статус %q"), line, status))
+			continue
+		}
+
+		tasks = append(tasks, model.Task{
+			Description: record[1],
+			Status:      status,
+			CreatedAt:   record[3],
+			UpdatedAt:   record[4],
+		})
+	}
+
+	return tasks, skipped, nil
+}
@@ -0,0 +1,71 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strings"
+	"testing"
+)
+
+func TestWriteCSVQuoting(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 1, Description: "milk, bread\nand \"eggs\"", Status: model.StatusTodo, CreatedAt: "2026-01-01T00:00:00Z", UpdatedAt: "2026-01-02T00:00:00Z"},
+		{Id: 2, Description: "plain", Status: model.StatusDone},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, tasks); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "id,description,status,createdAt,updatedAt\n" +
+		"1,\"milk, bread\nand \"\"eggs\"\"\",todo,2026-01-01T00:00:00Z,2026-01-02T00:00:00Z\n" +
+		"2,plain,done,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteCSV =\n%s\nwant:\n%s", got, want)
+	}
+
+	read, skipped, err := ReadCSV(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 0 {
+		t.Fatalf("ReadCSV skipped %v", skipped)
+	}
+	if len(read) != 2 || read[0].Description != tasks[0].Description {
+		t.Errorf("ReadCSV = %+v, want descriptions preserved", read)
+	}
+}
+
+func TestReadCSVSkipsInvalidRows(t *testing.T) {
+	data := "id,description,status,createdAt,updatedAt\n" +
+		"1,ok,todo,,\n" +
+		"2,\"  \",todo,,\n" +
+		"3,bad status,later,,\n" +
+		"4,\"two\nlines\",done,,\n" +
+		"5,,todo,,\n"
+
+	tasks, skipped, err := ReadCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 {
+		t.Errorf("ReadCSV returned %d tasks, want 2", len(tasks))
+	}
+
+	want := []string{
+		fmt.Sprintf(i18n.T("строка %d: пустое описание"), 3),
+		fmt.Sprintf(i18n.T("строка %d: неверный статус %q"), 4, "later"),
+		fmt.Sprintf(i18n.T("строка %d: пустое описание"), 7),
+	}
+	if len(skipped) != len(want) {
+		t.Fatalf("ReadCSV skipped %v, want %v", skipped, want)
+	}
+	for i, err := range skipped {
+		if err.Error() != want[i] {
+			t.Errorf("skipped[%d] = %q, want %q", i, err, want[i])
+		}
+	}
+}
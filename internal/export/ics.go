@@ -0,0 +1,94 @@
+package export
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405Z"
+	icsLineLimit      = 75
+)
+
+var icsEscaper = strings.NewReplacer("\\", "\\\\", ";", "\\;", ",", "\\,", "\r\n", "\\n", "\n", "\\n")
+
+var icsStatuses = map[model.TaskStatus]string{
+	model.StatusTodo:       "NEEDS-ACTION",
+	model.StatusInProgress: "IN-PROCESS",
+	model.StatusDone:       "COMPLETED",
+}
+
+func WriteICS(w io.Writer, tasks []model.Task) error {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//go-task-cli//task-cli//RU")
+
+	for _, task := range tasks {
+		due, ok := icsDue(task.DueDate)
+		if !ok {
+			continue
+		}
+
+		stamp, err := time.Parse(time.RFC3339, task.UpdatedAt)
+		if err != nil {
+			stamp = time.Now()
+		}
+		status, ok := icsStatuses[task.Status]
+		if !ok {
+			status = icsStatuses[model.StatusTodo]
+		}
+
+		writeICSLine(&b, "BEGIN:VTODO")
+		writeICSLine(&b, fmt.Sprintf("UID:task-%d@task-cli", task.Id))
+		writeICSLine(&b, "DTSTAMP:"+stamp.UTC().Format(icsDateTimeLayout))
+		writeICSLine(&b, "SUMMARY:"+icsEscaper.Replace(task.Description))
+		writeICSLine(&b, due)
+		writeICSLine(&b, "STATUS:"+status)
+		writeICSLine(&b, "END:VTODO")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи ICS: %v"), err)
+	}
+
+	return nil
+}
+
+func icsDue(dueDate string) (string, bool) {
+	if dueDate == "" {
+		return "", false
+	}
+
+	if t, err := time.Parse(timeutil.DateLayout, dueDate); err == nil {
+		return "DUE;VALUE=DATE:" + t.Format(icsDateLayout), true
+	}
+	if t, err := time.Parse(time.RFC3339, dueDate); err == nil {
+		return "DUE:" + t.UTC().Format(icsDateTimeLayout), true
+	}
+
+	return "", false
+}
+
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line + "\r\n")
+}
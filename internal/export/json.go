@@ -0,0 +1,26 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"io"
+)
+
+func WriteJSON(w io.Writer, tasks []model.Task) error {
+	if tasks == nil {
+		tasks = []model.Task{}
+	}
+
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка сериализации задач: %v"), err)
+	}
+
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи JSON: %v"), err)
+	}
+
+	return nil
+}
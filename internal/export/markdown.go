@@ -0,0 +1,31 @@
+package export
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"io"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+
+func WriteMarkdown(w io.Writer, tasks []model.Task) error {
+	var b strings.Builder
+	b.WriteString(i18n.T("| ID | Описание | Статус | Создано |\n"))
+	b.WriteString("|---:|---|---|---|\n")
+
+	for _, task := range tasks {
+		description := markdownEscaper.Replace(task.Description)
+		if task.Status == model.StatusDone && description != "" {
+			description = "~~" + description + "~~"
+		}
+		fmt.Fprintf(&b, "| %d | %s | %s | %s |\n", task.Id, description, task.Status, task.CreatedAt)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи Markdown: %v"), err)
+	}
+
+	return nil
+}
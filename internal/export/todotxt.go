@@ -0,0 +1,200 @@
+package export
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+var todoTxtPriorities = map[model.TaskPriority]string{
+	model.PriorityHigh:   "A",
+	model.PriorityMedium: "B",
+	model.PriorityLow:    "C",
+}
+
+func WriteTodoTxt(w io.Writer, tasks []model.Task) error {
+	var b strings.Builder
+	for _, task := range tasks {
+		var fields []string
+		priority := todoTxtPriorities[task.Priority]
+
+		if task.Status == model.StatusDone {
+			fields = append(fields, "x")
+			if date, ok := todoTxtDate(task.CompletedAt); ok {
+				fields = append(fields, date)
+			}
+		} else if priority != "" {
+			fields = append(fields, "("+priority+")")
+		}
+		if date, ok := todoTxtDate(task.CreatedAt); ok {
+			fields = append(fields, date)
+		}
+
+		fields = append(fields, strings.Fields(task.Description)...)
+		for _, tag := range task.Tags {
+			if strings.HasPrefix(tag, "@") {
+				fields = append(fields, tag)
+			} else {
+				fields = append(fields, "+"+tag)
+			}
+		}
+		if task.DueDate != "" {
+			fields = append(fields, "due:"+task.DueDate)
+		}
+		if task.Status != model.StatusTodo && task.Status != model.StatusDone {
+			fields = append(fields, "status:"+string(task.Status))
+		}
+		if task.Status == model.StatusDone && priority != "" {
+			fields = append(fields, "pri:"+priority)
+		}
+
+		b.WriteString(strings.Join(fields, " ") + "\n")
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи todo.txt: %v"), err)
+	}
+
+	return nil
+}
+
+func ReadTodoTxt(r io.Reader) ([]model.Task, []error, error) {
+	var tasks []model.Task
+	var skipped []error
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		task, err := parseTodoTxtLine(scanner.Text())
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf(i18n.T("строка %d: %v"), line, err))
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf(i18n.T("ошибка чтения todo.txt: %v"), err)
+	}
+
+	return tasks, skipped, nil
+}
+
+func parseTodoTxtLine(line string) (model.Task, error) {
+	task := model.Task{Status: model.StatusTodo, Priority: model.PriorityMedium}
+	fields := strings.Fields(line)
+
+	if fields[0] == "x" {
+		task.Status = model.StatusDone
+		fields = fields[1:]
+		if len(fields) != 0 {
+			if t, ok := parseTodoTxtDate(fields[0]); ok {
+				task.CompletedAt = t
+				fields = fields[1:]
+			}
+		}
+	} else if priority, ok := parseTodoTxtPriority(fields[0]); ok {
+		task.Priority = priority
+		fields = fields[1:]
+	}
+	if len(fields) != 0 {
+		if t, ok := parseTodoTxtDate(fields[0]); ok {
+			task.CreatedAt = t
+			fields = fields[1:]
+		}
+	}
+
+	var words []string
+	for _, field := range fields {
+		switch {
+		case len(field) > 1 && field[0] == '+':
+			task.Tags = appendTag(task.Tags, field[1:])
+			continue
+		case len(field) > 1 && field[0] == '@':
+			task.Tags = appendTag(task.Tags, field)
+			continue
+		}
+
+		key, value, ok := strings.Cut(field, ":")
+		if ok && value != "" {
+			switch key {
+			case "due":
+				if _, err := timeutil.ParseDate(value); err != nil {
+					return model.Task{}, err
+				}
+				task.DueDate = value
+				continue
+			case "status":
+				task.Status = model.TaskStatus(value)
+				continue
+			case "pri":
+				if priority, ok := parseTodoTxtPriority("(" + value + ")"); ok {
+					task.Priority = priority
+					continue
+				}
+			}
+		}
+		words = append(words, field)
+	}
+
+	if len(words) == 0 {
+		return model.Task{}, errors.New(i18n.T("пустое описание"))
+	}
+	if !model.IsValidStatus(task.Status) {
+		return model.Task{}, fmt.Errorf(i18n.T("неверный статус %q"), task.Status)
+	}
+	task.Description = strings.Join(words, " ")
+
+	return task, nil
+}
+
+func parseTodoTxtPriority(field string) (model.TaskPriority, bool) {
+	if len(field) != 3 || field[0] != '(' || field[2] != ')' || field[1] < 'A' || field[1] > 'Z' {
+		return "", false
+	}
+
+	switch field[1] {
+	case 'A':
+		return model.PriorityHigh, true
+	case 'B':
+		return model.PriorityMedium, true
+	default:
+		return model.PriorityLow, true
+	}
+}
+
+func todoTxtDate(timestamp string) (string, bool) {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "", false
+	}
+
+	return t.Local().Format(timeutil.DateLayout), true
+}
+
+func parseTodoTxtDate(field string) (string, bool) {
+	t, err := time.ParseInLocation(timeutil.DateLayout, field, time.Local)
+	if err != nil {
+		return "", false
+	}
+
+	return t.UTC().Format(time.RFC3339), true
+}
+
+func appendTag(tags []string, tag string) []string {
+	tag = strings.ToLower(tag)
+	if slices.Contains(tags, tag) {
+		return tags
+	}
+
+	return append(tags, tag)
+}
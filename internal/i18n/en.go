@@ -0,0 +1,399 @@
+package i18n
+
+var en = map[string]string{
+	"        _describe 'команда' commands\n":                                            "        _describe 'command' commands\n",
+	"  --backend <json|sqlite> - Хранилище задач (приоритетнее TASK_CLI_BACKEND)":       "  --backend <json|sqlite> - Task storage (overrides TASK_CLI_BACKEND)",
+	"  --compact - Сохранять файл задач в одну строку (как TASK_CLI_COMPACT=1)":         "  --compact - Save the tasks file on a single line (same as TASK_CLI_COMPACT=1)",
+	"  --dry-run - Показать результат команды, не записывая изменения":                  "  --dry-run - Show what a command would do without writing changes",
+	"  --file <путь> - Файл задач (приоритетнее TASK_CLI_FILE)":                         "  --file <path> - Tasks file (overrides TASK_CLI_FILE)",
+	"  --project <имя> - Проект со своим списком задач (приоритетнее TASK_CLI_PROJECT)": "  --project <name> - Project with its own task list (overrides TASK_CLI_PROJECT)",
+	"  exit, quit - Сохранить изменения и выйти":                                        "  exit, quit - Save changes and exit",
+	"  save - Сохранить изменения":                                                      "  save - Save changes",
+	" (%d/%d выполнено)": " (%d/%d done)",
+	" [--force] <id|диапазон>[,...] [id...] | --all | --status <статус>": " [--force] <id|range>[,...] [id...] | --all | --status <status>",
+	" назад":          " ago",
+	"--- заметки ---": "--- notes ---",
+	"<csv|md|json|ics|todotxt> [--status <статус>] [--todo] [--in-progress] [--done] [--tag <тег>] [--assignee <имя|none>] [--archived] [--blocked] [--pinned] [--since <дата|срок>] [файл]": "<csv|md|json|ics|todotxt> [--status <status>] [--todo] [--in-progress] [--done] [--tag <tag>] [--assignee <name|none>] [--archived] [--blocked] [--pinned] [--since <date|age>] [file]",
+	"<csv|todotxt> <файл>":                     "<csv|todotxt> <file>",
+	"<id источника> <id назначения>":           "<source id> <destination id>",
+	"<id> <id зависимости>":                    "<id> <dependency id>",
+	"<id> <дата>":                              "<id> <date>",
+	"<id> <имя>":                               "<id> <name>",
+	"<id> <описание>":                          "<id> <description>",
+	"<id> <позиция>":                           "<id> <position>",
+	"<id> <срок>":                              "<id> <duration>",
+	"<id> <тег>":                               "<id> <tag>",
+	"<id> <текст>":                             "<id> <text>",
+	"<id> [id...] <статус>":                    "<id> [id...] <status>",
+	"<id> [id...] | --all | --status <статус>": "<id> [id...] | --all | --status <status>",
+	"<id> [текст|-]":                           "<id> [text|-]",
+	"N самых важных незавершенных задач (по умолчанию 3)":                 "The N most important open tasks (3 by default)",
+	"[%d] %s - срок: %s, просрочено на %s\n":                              "[%d] %s - due: %s, overdue by %s\n",
+	"[--done [--force|-y]] [--older-than <срок>]":                         "[--done [--force|-y]] [--older-than <age>]",
+	"[--exact|--regexp] <запрос>":                                         "[--exact|--regexp] <query>",
+	"[--force] <id> <поле>=<значение> [...]":                              "[--force] <id> <field>=<value> [...]",
+	"[--force] <id> [id...] | --all | --status <статус>":                  "[--force] <id> [id...] | --all | --status <status>",
+	"[--hard] [--cascade] [--force|-y] <id|диапазон>[,...]":               "[--hard] [--cascade] [--force|-y] <id|range>[,...]",
+	"[--interval <длительность>] [статус]":                                "[--interval <duration>] [status]",
+	"[-q] [--parent <id>] [--no-dup] <описание|-> | --from-file <файл|->": "[-q] [--parent <id>] [--no-dup] <description|-> | --from-file <file|->",
+	"[команда]": "[command]",
+	"[статус] [--status <статус>] [--todo] [--in-progress] [--done] [--tag <тег>] [--assignee <имя|none>] [--archived] [--blocked] [--pinned] [--since <дата|срок>] [--sort <ключ>] [--reverse] [--limit N] [--offset N] [--json|--table|--format <шаблон>] [--relative] [--utc] [--verbose] [--group] [-q]": "[status] [--status <status>] [--todo] [--in-progress] [--done] [--tag <tag>] [--assignee <name|none>] [--archived] [--blocked] [--pinned] [--since <date|age>] [--sort <key>] [--reverse] [--limit N] [--offset N] [--json|--table|--format <template>] [--relative] [--utc] [--verbose] [--group] [-q]",
+	"add --parent 3 Позвонить поставщику":                          "add --parent 3 Call the supplier",
+	"add [-q] [--parent <id>] --from-file <файл|->":                "add [-q] [--parent <id>] --from-file <file|->",
+	"add [-q] [--parent <id>] [--no-dup] <описание|->":             "add [-q] [--parent <id>] [--no-dup] <description|->",
+	"add \"Купить молоко\"":                                        "add \"Buy milk\"",
+	"append <id> <текст>":                                          "append <id> <text>",
+	"assign <id> <имя>":                                            "assign <id> <name>",
+	"delete [--hard] [--cascade] [--force|-y] <id|диапазон>[,...]": "delete [--hard] [--cascade] [--force|-y] <id|range>[,...]",
+	"depend <id> <id зависимости>":                                 "depend <id> <dependency id>",
+	"due <id> <дата>":                                              "due <id> <date>",
+	"export <csv|md|json|ics|todotxt> [флаги фильтра list] [файл]": "export <csv|md|json|ics|todotxt> [list filter flags] [file]",
+	"help [команда]":                                               "help [command]",
+	"history [id]":                                                 "history [id]",
+	"import <csv|todotxt> <файл>":                                  "import <csv|todotxt> <file>",
+	"list [статус] [флаги...]":                                     "list [status] [flags...]",
+	"mark [--force] <id|диапазон>[,...] [id...] <статус>":          "mark [--force] <id|range>[,...] [id...] <status>",
+	"merge <id источника> <id назначения>":                         "merge <source id> <destination id>",
+	"move <id> <позиция>":                                          "move <id> <position>",
+	"note <id> [текст|-]":                                          "note <id> [text|-]",
+	"purge [--done [--force|-y]] [--older-than <срок>]":            "purge [--done [--force|-y]] [--older-than <age>]",
+	"search [--exact|--regexp] <запрос>":                           "search [--exact|--regexp] <query>",
+	"search молоко":                                                "search milk",
+	"set [--force] <id> <поле>=<значение> [...]":                   "set [--force] <id> <field>=<value> [...]",
+	"snooze <id> <срок>":                                           "snooze <id> <duration>",
+	"tag <id> <тег>":                                               "tag <id> <tag>",
+	"untag <id> <тег>":                                             "untag <id> <tag>",
+	"update 2 \"Купить хлеб\"":                                     "update 2 \"Buy bread\"",
+	"update <id> <описание>":                                       "update <id> <description>",
+	"watch [--interval <длительность>] [статус]":                   "watch [--interval <duration>] [status]",
+	"| ID | Описание | Статус | Создано |\n":                       "| ID | Description | Status | Created |\n",
+	"Архив:":     "Archived:",
+	"В процессе": "In progress",
+	"Вернуть выполненную или начатую задачу в статус todo": "Move a done or started task back to todo",
+	"Вернуть задачу из архива":                             "Restore a task from the archive",
+	"Версия программы":                                     "Program version",
+	"Восстановить задачу из корзины":                       "Restore a task from the trash",
+	"Все задачи выполнены, можно отдохнуть!":               "All tasks are done, time to relax!",
+	"Всего задач:":                                         "Total tasks:",
+	"Выполнено":                                            "Done",
+	"Выполнено: %.1f%%\n":                                  "Done: %.1f%%\n",
+	"Добавить зависимость задачи от другой задачи":         "Make a task depend on another task",
+	"Добавить новую задачу (или подзадачу)":                "Add a new task (or subtask)",
+	"Добавить строку к описанию задачи":                    "Append a line to the task description",
+	"Добавить тег задаче":                                  "Add a tag to a task",
+	"Добавлено задач: %d\n":                                "Tasks added: %d\n",
+	"Журнал изменений задач (с id только одной задачи)":    "Log of task changes (only one task with an id)",
+	"Завершено:":                                           "Completed:",
+	"Зависит от:":                                          "Depends on:",
+	"Задать заметки задачи (без текста очистить, - прочитать из stdin)": "Set task notes (no text clears them, - reads from stdin)",
+	"Задача %d объединена с задачей %d и перемещена в корзину\n":        "Task %d merged into task %d and moved to the trash\n",
+	"Задача %d теперь зависит от задачи %d\n":                           "Task %d now depends on task %d\n",
+	"Задача возвращена в работу (ID: %d)\n":                             "Task reopened (ID: %d)\n",
+	"Задача возвращена из архива (ID: %d)\n":                            "Task restored from the archive (ID: %d)\n",
+	"Задача восстановлена из корзины (ID: %d)\n":                        "Task restored from the trash (ID: %d)\n",
+	"Задача добавлена успешно (ID: %d)\n":                               "Task added successfully (ID: %d)\n",
+	"Задача закреплена (ID: %d)\n":                                      "Task pinned (ID: %d)\n",
+	"Задача назначена на %s (ID: %d)\n":                                 "Task assigned to %s (ID: %d)\n",
+	"Задача обновлена успешно (ID: %d)\n":                               "Task updated successfully (ID: %d)\n",
+	"Задача откреплена (ID: %d)\n":                                      "Task unpinned (ID: %d)\n",
+	"Задача отложена, новый срок: %s (ID: %d)\n":                        "Task snoozed, new due date: %s (ID: %d)\n",
+	"Задача переведена в статус %s (ID: %d)\n":                          "Task moved to status %s (ID: %d)\n",
+	"Задача перемещена в архив (ID: %d)\n":                              "Task moved to the archive (ID: %d)\n",
+	"Задача перемещена на позицию %d (ID: %d)\n":                        "Task moved to position %d (ID: %d)\n",
+	"Задача пометлена как TODO (ID: %d)\n":                              "Task marked as TODO (ID: %d)\n",
+	"Задача пометлена как в процессе (ID: %d)\n":                        "Task marked as in progress (ID: %d)\n",
+	"Задача пометлена как выполненная (ID: %d)\n":                       "Task marked as done (ID: %d)\n",
+	"Задача скопирована (ID: %d -> %d)\n":                               "Task cloned (ID: %d -> %d)\n",
+	"Задачи не найдены.":                                                "No tasks found.",
+	"Задачи перемещены в корзину (ID: %s)\n":                            "Tasks moved to the trash (ID: %s)\n",
+	"Задачи поменяны местами (ID: %d, %d)\n":                            "Tasks swapped (ID: %d, %d)\n",
+	"Задачи удалены (ID: %s)\n":                                         "Tasks deleted (ID: %s)\n",
+	"Задачи:":                                                           "Tasks:",
+	"Закрепить задачу в начале списка":                                  "Pin a task to the top of the list",
+	"Заметки задачи очищены (ID: %d)\n":                                 "Task notes cleared (ID: %d)\n",
+	"Заметки задачи сохранены (ID: %d)\n":                               "Task notes saved (ID: %d)\n",
+	"Заметки:": "Notes:",
+	"Запустить таймер задачи":                                                        "Start the task timer",
+	"Затрачено времени: %s\n":                                                        "Time spent: %s\n",
+	"Изменений нет (ID: %d)\n":                                                       "No changes (ID: %d)\n",
+	"Изменения сохранены":                                                            "Changes saved",
+	"Изменено задач: %d\n":                                                           "Tasks changed: %d\n",
+	"Изменить описание и заметки задачи в редакторе $EDITOR":                         "Edit the task description and notes in $EDITOR",
+	"Изменить поля задачи: description, status, priority, due, tags (через запятую)": "Change task fields: description, status, priority, due, tags (comma separated)",
+	"Импорт задач из CSV или todo.txt с назначением новых ID":                        "Import tasks from CSV or todo.txt with new IDs",
+	"Импортировано задач: %d, пропущено: %d\n":                                       "Imported tasks: %d, skipped: %d\n",
+	"Интерактивный режим task-cli. Введите help для списка команд, exit для выхода":  "task-cli interactive mode. Type help for the list of commands, exit to quit",
+	"Интерактивный режим":                                                            "Interactive mode",
+	"Исполнитель снят (ID: %d)\n":                                                    "Assignee removed (ID: %d)\n",
+	"Исполнитель:":                                                                   "Assignee:",
+	"Использование: task-cli ":                                                       "Usage: task-cli ",
+	"Использование: task-cli [--file <путь>] [--backend <json|sqlite>] [--project <имя>] [--dry-run] [--compact] <команда> [аргументы...]": "Usage: task-cli [--file <path>] [--backend <json|sqlite>] [--project <name>] [--dry-run] [--compact] <command> [arguments...]",
+	"Использование:":                       "Usage:",
+	"История пуста.":                       "History is empty.",
+	"Количество задач всего и по статусам": "Number of tasks in total and by status",
+	"Команды интерактивного режима:":       "Interactive mode commands:",
+	"Команды:":                                 "Commands:",
+	"Корзина пуста.":                           "The trash is empty.",
+	"Назначить исполнителя задачи":             "Assign a task to someone",
+	"Найдены проблемы:":                        "Problems found:",
+	"Обновить задачу":                          "Update a task",
+	"Обновлено в %s, Ctrl+C для выхода\n\n":    "Updated at %s, Ctrl+C to exit\n\n",
+	"Обновлено":                                "Updated",
+	"Обновлено:":                               "Updated:",
+	"Окончательно удалено задач: %d\n":         "Permanently deleted tasks: %d\n",
+	"Окончательно удалить выполненные задачи?": "Permanently remove completed tasks?",
+	"Окончательно удалить задачи из корзины (с --done выполненные задачи)": "Permanently remove tasks from the trash (with --done, completed tasks)",
+	"Описание задачи дополнено (ID: %d)\n":                                 "Task description appended (ID: %d)\n",
+	"Описание":  "Description",
+	"Описание:": "Description:",
+	"Остановить таймер задачи": "Stop the task timer",
+	"Открепить задачу":         "Unpin a task",
+	"Отложить срок задачи на указанный срок (например, 2d, 1w или 3h)": "Push the task's due date forward by a duration (e.g. 2d, 1w or 3h)",
+	"Отменено": "Cancelled",
+	"Отменить последнее изменение":   "Undo the last change",
+	"Отметить задачи как TODO":       "Mark tasks as TODO",
+	"Отметить задачи как в процессе": "Mark tasks as in progress",
+	"Отметить задачи как выполненные (--force игнорирует незавершенные зависимости)": "Mark tasks as done (--force ignores incomplete dependencies)",
+	"Ошибка инициализации конфига: %v\n":                                             "Config initialization error: %v\n",
+	"Ошибка открытия хранилища: %v\n":                                                "Failed to open storage: %v\n",
+	"Ошибка сохранения: %v\n":                                                        "Save error: %v\n",
+	"Ошибка чтения ввода: %v\n":                                                      "Input read error: %v\n",
+	"Ошибка: %v\n": "Error: %v\n",
+	"Ошибка: интерактивный режим уже запущен":                                                                     "Error: interactive mode is already running",
+	"Перевести задачи в любой допустимый статус":                                                                  "Move tasks to any allowed status",
+	"Переместить задачи в корзину (например: 1-3,5), с --hard удалить навсегда, с --cascade вместе с подзадачами": "Move tasks to the trash (e.g. 1-3,5), --hard deletes them permanently, --cascade includes subtasks",
+	"Переместить задачу в архив":                                                                                  "Move a task to the archive",
+	"Переместить задачу на позицию в списке":                                                                      "Move a task to a position in the list",
+	"Перенести описание, заметки и теги задачи в другую задачу и удалить ее":                                      "Move a task's description, notes and tags into another task and delete it",
+	"Подробнее о команде: task-cli help <команда> или task-cli <команда> --help":                                  "Command details: task-cli help <command> or task-cli <command> --help",
+	"Поиск задач по описанию без учета регистра, по точному совпадению или регулярному выражению":                 "Search task descriptions case-insensitively, by exact match or by regular expression",
+	"Поменять две задачи местами в списке":                                                                        "Swap two tasks in the list",
+	"Последнее изменение отменено":                                                                                "Last change undone",
+	"Предупреждение: %v\n": "Warning: %v\n",
+	"Предупреждение: неверный формат времени TASK_CLI_TIME_FORMAT %q, используется RFC3339\n": "Warning: invalid TASK_CLI_TIME_FORMAT time format %q, using RFC3339\n",
+	"Примеры:": "Examples:",
+	"Приоритет задачи установлен: %s (ID: %d)\n": "Task priority set: %s (ID: %d)\n",
+	"Приоритет:":         "Priority:",
+	"Проблем не найдено": "No problems found",
+	"Проблемы исправлены: ID сделаны уникальными, неверные статусы заменены на todo, отсутствующие метки времени заполнены": "Problems fixed: IDs made unique, invalid statuses replaced with todo, missing timestamps filled in",
+	"Пробный запуск: изменения не записаны":                                         "Dry run: no changes were written",
+	"Проверить файл задач на ошибки (с --fix исправить их)":                         "Check the tasks file for errors (--fix repairs them)",
+	"Проверить, что файл задач в каноническом формате (с --rewrite переписать его)": "Check that the tasks file is in canonical form (rewrite it with --rewrite)",
+	"Проекты:":                          "Projects:",
+	"Пропущено: %v\n":                   "Skipped: %v\n",
+	"Просроченные задачи:":              "Overdue tasks:",
+	"Просроченных задач нет.":           "No overdue tasks.",
+	"Родитель:":                         "Parent:",
+	"Самая важная незавершенная задача": "The most important unfinished task",
+	"Синонимы:":                         "Aliases:",
+	"Скрипт автодополнения для командной оболочки": "Shell completion script",
+	"Снять исполнителя задачи":                     "Remove the task assignee",
+	"Создано:": "Created:",
+	"Создать копию задачи в статусе todo":                                                  "Create a copy of a task with status todo",
+	"Список всех задач или задач по статусу (todo, in-progress, done), тегу и исполнителю": "List all tasks or tasks by status (todo, in-progress, done), tag and assignee",
+	"Список задач в корзине":                                                               "List tasks in the trash",
+	"Список задач во весь экран с обновлением при изменении файла":                         "Full-screen task list that refreshes when the file changes",
+	"Список задач со сроком на сегодня":                                                    "List tasks due today",
+	"Список проектов в каталоге хранилища":                                                 "List projects in the storage directory",
+	"Список просроченных задач":                                                            "List overdue tasks",
+	"Справка по командам или по одной команде":                                             "Help for all commands or a single command",
+	"Среднее время выполнения: нет данных":                                                 "Average time to completion: no data",
+	"Среднее время выполнения:":                                                            "Average time to completion:",
+	"Срок задачи установлен на %s (ID: %d)\n":                                              "Task due date set to %s (ID: %d)\n",
+	"Срок:": "Due:",
+	"Статистика выполнения задач": "Task completion statistics",
+	"Статус":  "Status",
+	"Статус:": "Status:",
+	"Суммарное затраченное на задачу время":   "Total time spent on a task",
+	"Таймер запущен (ID: %d)\n":               "Timer started (ID: %d)\n",
+	"Таймер запущен":                          "Timer is running",
+	"Таймер остановлен, прошло %s (ID: %d)\n": "Timer stopped, %s elapsed (ID: %d)\n",
+	"Такая задача уже есть (ID: %d)\n":        "This task already exists (ID: %d)\n",
+	"Тег %q добавлен (ID: %d)\n":              "Tag %q added (ID: %d)\n",
+	"Тег %q удален (ID: %d)\n":                "Tag %q removed (ID: %d)\n",
+	"Тег %q уже есть у задачи (ID: %d)\n":     "Task already has tag %q (ID: %d)\n",
+	"Теги:":                       "Tags:",
+	"Убрать тег у задачи":         "Remove a tag from a task",
+	"Удалено задач: %d\n":         "Deleted tasks: %d\n",
+	"Удалено:":                    "Deleted:",
+	"Удалить все задачи":          "Delete all tasks",
+	"Удалить все задачи?":         "Delete all tasks?",
+	"Удалить?":                    "Delete?",
+	"Установить приоритет задачи": "Set the task priority",
+	"Установить срок задачи (ГГГГ-ММ-ДД, RFC3339, today, tomorrow, next <день недели>, +Nd)": "Set the task due date (YYYY-MM-DD, RFC3339, today, tomorrow, next <weekday>, +Nd)",
+	"Файл задач в каноническом формате":                                                      "The tasks file is in canonical form",
+	"Файл задач переписан в каноническом формате":                                            "The tasks file was rewritten in canonical form",
+	"Флаги:": "Flags:",
+	"Экспорт задач (всех или по фильтрам list) в CSV, Markdown, JSON или todo.txt, задач со сроком в календарь ICS (по умолчанию в stdout)": "Export tasks (all or by list filters) to CSV, Markdown, JSON or todo.txt, tasks with a due date to an ICS calendar (stdout by default)",
+	"Экспортировано задач: %d (%s)\n":                                            "Exported tasks: %d (%s)\n",
+	"в файле задач несколько задач с ID %d, исправьте их командой doctor --fix":  "the tasks file has several tasks with ID %d, fix them with doctor --fix",
+	"в файле задач повторяются ID %s, исправьте их командой doctor --fix":        "duplicate IDs %s in the tasks file, fix them with doctor --fix",
+	"в файле конфигурации для list допустимы только флаги, а не %q":              "only flags are allowed for list in the configuration file, not %q",
+	"в файле конфигурации не указан встроенный статус %q":                        "built-in status %q is missing from the config file",
+	"версия формата файла задач %d новее поддерживаемой (%d), обновите task-cli": "tasks file format version %d is newer than supported (%d), please upgrade task-cli",
+	"год":  "year",
+	"года": "years",
+	"д":    "d",
+	"день": "day",
+	"дней": "days",
+	"дня":  "days",
+	"зависимость создает цикл: задача с ID %d уже зависит от задачи с ID %d": "dependency creates a cycle: task with ID %d already depends on task with ID %d",
+	"задач": "tasks",
+	"задача #%d (ID %d): неверный статус %q":                                      "task #%d (ID %d): invalid status %q",
+	"задача #%d (ID %d): отсутствует или неверно время обновления":                "task #%d (ID %d): missing or invalid update time",
+	"задача #%d (ID %d): отсутствует или неверно время создания":                  "task #%d (ID %d): missing or invalid creation time",
+	"задача #%d: неверный ID %d":                                                  "task #%d: invalid ID %d",
+	"задача #%d: повторяющийся ID %d":                                             "task #%d: duplicate ID %d",
+	"задача не может зависеть от самой себя":                                      "a task cannot depend on itself",
+	"задача с ID %d зависит от незавершенных задач (ID: %s), используйте --force": "task with ID %d depends on unfinished tasks (ID: %s), use --force",
+	"задача с ID %d не в архиве":                                                  "task with ID %d is not archived",
+	"задача с ID %d не закреплена":                                                "task with ID %d is not pinned",
+	"задача с ID %d не найдена в корзине":                                         "task with ID %d not found in the trash",
+	"задача с ID %d не найдена":                                                   "task with ID %d not found",
+	"задача с ID %d уже в архиве":                                                 "task with ID %d is already archived",
+	"задача с ID %d уже в статусе todo":                                           "task with ID %d is already todo",
+	"задача с ID %d уже зависит от задачи с ID %d":                                "task with ID %d already depends on task with ID %d",
+	"задача с ID %d уже закреплена":                                               "task with ID %d is already pinned",
+	"задача": "task",
+	"задачи не найдены (ID: %s)": "tasks not found (ID: %s)",
+	"задачи": "tasks",
+	"значения limit и offset не могут быть отрицательными": "limit and offset cannot be negative",
+	"идентификатор задачи должен быть положительным: %s":   "task ID must be positive: %s",
+	"интерактивный режим уже запущен":                      "interactive mode is already running",
+	"количество задач должно быть положительным: %d":       "task count must be positive: %d",
+	"команда history недоступна в интерактивном режиме":    "the history command is not available in interactive mode",
+	"команда projects недоступна в интерактивном режиме":   "the projects command is not available in interactive mode",
+	"команда watch недоступна в интерактивном режиме":      "the watch command is not available in interactive mode",
+	"лет":     "years",
+	"м":       "m",
+	"месяц":   "month",
+	"месяца":  "months",
+	"месяцев": "months",
+	"минут":   "minutes",
+	"минуту":  "minute",
+	"минуты":  "minutes",
+	"найдено проблем: %d, запустите doctor --fix для исправления":                            "problems found: %d, run doctor --fix to repair them",
+	"не указаны идентификаторы задач":                                                        "no task ids given",
+	"неверная версия формата файла задач %d":                                                 "invalid tasks file format version %d",
+	"неверная команда: %s":                                                                   "invalid command: %s",
+	"неверная команда: %s, возможно, вы имели в виду '%s'?":                                  "invalid command: %s, did you mean '%s'?",
+	"неверная позиция %d (допустимо от 1 до %d)":                                             "invalid position %d (allowed from 1 to %d)",
+	"неверная позиция %q":                                                                    "invalid position %q",
+	"неверное имя проекта %q (допустимы латинские буквы, цифры, _ и -)":                      "invalid project name %q (allowed: latin letters, digits, _ and -)",
+	"неверное количество задач %q":                                                           "invalid task count %q",
+	"неверное начало периода %q (ожидается ГГГГ-ММ-ДД, RFC3339 или срок, например 7d)":       "invalid period start %q (expected YYYY-MM-DD, RFC3339 or an age such as 7d)",
+	"неверное присваивание %q (ожидается поле=значение)":                                     "invalid assignment %q (expected field=value)",
+	"неверное регулярное выражение %q: %v":                                                   "invalid regular expression %q: %v",
+	"неверные флаги: %v":                                                                     "invalid flags: %v",
+	"неверный диапазон %q":                                                                   "invalid range %q",
+	"неверный заголовок CSV: ожидается %v":                                                   "invalid CSV header: expected %v",
+	"неверный идентификатор задачи %q":                                                       "invalid task id %q",
+	"неверный ключ сортировки %q (допустимо: order, id, created, updated, status, priority)": "invalid sort key %q (allowed: order, id, created, updated, status, priority)",
+	"неверный приоритет %q (допустимо: low, medium, high)":                                   "invalid priority %q (allowed: low, medium, high)",
+	"неверный срок %q (ожидается, например, 30, 30d, 2w или 12h)":                            "invalid age %q (expected e.g. 30, 30d, 2w or 12h)",
+	"неверный статус %q (допустимо: %s)":                                                     "invalid status %q (allowed: %s)",
+	"неверный статус %q в файле конфигурации":                                                "invalid status %q in the config file",
+	"неверный статус %q":                                                                     "invalid status %q",
+	"неверный формат даты %q (ожидается ГГГГ-ММ-ДД или RFC3339)":                             "invalid date format %q (expected YYYY-MM-DD or RFC3339)",
+	"неверный формат импорта: %s":                                                            "invalid import format: %s",
+	"неверный формат экспорта: %s":                                                           "invalid export format: %s",
+	"неверный шаблон --format: %v":                                                           "invalid --format template: %v",
+	"незакрытая кавычка":                                                                     "unclosed quote",
+	"неизвестное поле %q (допустимо: %s)":                                                    "unknown field %q (allowed: %s)",
+	"неизвестное хранилище %q (допустимо: %s, %s)":                                           "unknown storage %q (allowed: %s, %s)",
+	"нельзя объединить задачу с ID %d с самой собой":                                         "cannot merge task with ID %d into itself",
+	"нельзя поменять задачу с ID %d местами с самой собой":                                   "cannot swap task with ID %d with itself",
+	"неожиданный тип значения %T":                                                            "unexpected value type %T",
+	"неподдерживаемая оболочка %q (допустимо: bash, zsh, fish)":                              "unsupported shell %q (allowed: bash, zsh, fish)",
+	"нет сохраненного состояния для отмены":                                                  "no saved state to undo",
+	"нет терминала для подтверждения, используйте --force":                                   "no terminal to confirm, use --force",
+	"объединение создает цикл: задача с ID %d зависит от задачи с ID %d":                     "merge would create a cycle: task with ID %d depends on task with ID %d",
+	"описание задачи не может быть пустым":                                                   "task description cannot be empty",
+	"ошибка блокировки файла задач: %v":                                                      "tasks file lock error: %v",
+	"ошибка в настройке list файла конфигурации: %v":                                         "error in the list setting of the configuration file: %v",
+	"ошибка восстановления резервной копии: %v":                                              "failed to restore backup: %v",
+	"ошибка выполнения шаблона --format: %v":                                                 "failed to execute --format template: %v",
+	"ошибка загрузки задач: %v":                                                              "failed to load tasks: %v",
+	"ошибка загрузки задач: %w":                                                              "failed to load tasks: %w",
+	"ошибка загрузки счетчика ID: %w":                                                        "failed to load id counter: %w",
+	"ошибка записи CSV: %v":                                                                  "failed to write CSV: %v",
+	"ошибка записи ICS: %v":                                                                  "failed to write ICS: %v",
+	"ошибка записи JSON: %v":                                                                 "error writing JSON: %v",
+	"ошибка записи Markdown: %v":                                                             "failed to write Markdown: %v",
+	"ошибка записи todo.txt: %v":                                                             "failed to write todo.txt: %v",
+	"ошибка записи временного файла: %v":                                                     "failed to write temporary file: %v",
+	"ошибка записи задач: %v":                                                                "failed to write tasks: %v",
+	"ошибка записи задачи с ID %d: %v":                                                       "failed to write task with ID %d: %v",
+	"ошибка записи истории: %v":                                                              "error writing history: %v",
+	"ошибка записи счетчика ID: %v":                                                          "failed to write id counter: %v",
+	"ошибка записи файла задач: %v":                                                          "failed to write tasks file: %v",
+	"ошибка записи файла задач: %w":                                                          "failed to write tasks file: %w",
+	"ошибка записи файла экспорта: %v":                                                       "error writing export file: %v",
+	"ошибка запуска редактора: %v":                                                           "failed to start the editor: %v",
+	"ошибка миграции с версии %d: %v":                                                        "failed to migrate from version %d: %v",
+	"ошибка обновления схемы базы задач: %v":                                                 "failed to upgrade task database schema: %v",
+	"ошибка открытия базы задач: %v":                                                         "failed to open task database: %v",
+	"ошибка открытия файла импорта: %v":                                                      "failed to open import file: %v",
+	"ошибка открытия файла: %v":                                                              "failed to open file: %v",
+	"ошибка парсинга истории в строке %d: %v":                                                "error parsing history at line %d: %v",
+	"ошибка парсинга счетчика ID: %v":                                                        "failed to parse id counter: %v",
+	"ошибка парсинга файла задач: %v":                                                        "failed to parse tasks file: %v",
+	"ошибка парсинга файла конфигурации %s: %v":                                              "failed to parse config file %s: %v",
+	"ошибка поиска проектов: %v":                                                             "failed to search for projects: %v",
+	"ошибка распаковки файла задач: %v":                                                      "failed to decompress tasks file: %v",
+	"ошибка сериализации задач: %v":                                                          "failed to serialize tasks: %v",
+	"ошибка сериализации истории: %v":                                                        "error serializing history: %v",
+	"ошибка сериализации: %v":                                                                "serialization error: %v",
+	"ошибка сжатия файла задач: %v":                                                          "failed to compress tasks file: %v",
+	"ошибка создания временного файла: %v":                                                   "failed to create temporary file: %v",
+	"ошибка создания резервной копии: %v":                                                    "failed to create backup: %v",
+	"ошибка создания схемы базы задач: %v":                                                   "failed to create task database schema: %v",
+	"ошибка создания файла экспорта: %v":                                                     "failed to create export file: %v",
+	"ошибка чтения CSV: %v":                                                                  "failed to read CSV: %v",
+	"ошибка чтения stdin: %v":                                                                "failed to read stdin: %v",
+	"ошибка чтения todo.txt: %v":                                                             "failed to read todo.txt: %v",
+	"ошибка чтения временного файла: %v":                                                     "failed to read temporary file: %v",
+	"ошибка чтения задачи: %v":                                                               "failed to read task: %v",
+	"ошибка чтения истории: %v":                                                              "error reading history: %v",
+	"ошибка чтения схемы базы задач: %v":                                                     "failed to read task database schema: %v",
+	"ошибка чтения счетчика ID: %v":                                                          "failed to read id counter: %v",
+	"ошибка чтения файла конфигурации: %v":                                                   "failed to read config file: %v",
+	"ошибка чтения файла: %v":                                                                "failed to read file: %v",
+	"поисковый запрос не может быть пустым":                                                  "search query cannot be empty",
+	"поле %q указано несколько раз":                                                          "field %q is given more than once",
+	"проверка формата поддерживается только для хранилища json":                              "format verification is only supported for the json backend",
+	"пустое описание":                                                                        "empty description",
+	"редактор %q не найден, укажите его в переменной EDITOR":                                 "editor %q not found, set it in the EDITOR variable",
+	"редактор завершился с ошибкой, задача не изменена: %v":                                  "the editor exited with an error, the task was not changed: %v",
+	"режимы --exact и --regexp несовместимы":                                                 "the --exact and --regexp modes cannot be combined",
+	"родительская задача с ID %d не найдена":                                                 "parent task with ID %d not found",
+	"с": "s",
+	"слишком большой диапазон %q":                                                       "range %q is too large",
+	"срок откладывания должен быть положительным":                                       "snooze duration must be positive",
+	"статус %q указан в файле конфигурации несколько раз":                               "status %q is listed in the config file more than once",
+	"статус нельзя указывать вместе с флагами --status, --todo, --in-progress и --done": "a status cannot be combined with the --status, --todo, --in-progress and --done flags",
+	"строка %d: %v": "line %d: %v",
+	"строка %d: неверное количество полей": "line %d: wrong number of fields",
+	"строка %d: неверный статус %q":        "line %d: invalid status %q",
+	"строка %d: пустое описание":           "line %d: empty description",
+	"таймер задачи с ID %d не запущен":     "timer of task with ID %d is not running",
+	"таймер задачи с ID %d уже запущен":    "timer of task with ID %d is already running",
+	"тег не может быть пустым":             "tag cannot be empty",
+	"только что": "just now",
+	"у задачи с ID %d есть подзадачи, используйте --cascade":                           "task with ID %d has subtasks, use --cascade",
+	"у задачи с ID %d нет тега %q":                                                     "task with ID %d has no tag %q",
+	"файл задач занят другим процессом (если это не так, удалите %s)":                  "tasks file is used by another process (if not, delete %s)",
+	"файл задач не в каноническом формате, запустите verify --rewrite для исправления": "the tasks file is not in canonical form, run verify --rewrite to fix it",
+	"файл задач не удалось прочитать, исправьте его вручную: %w":                       "failed to read the tasks file, fix it manually: %w",
+	"флаг --%s требует значение":                                                       "flag --%s requires a value",
+	"флаг --format несовместим с --json, --table и --group":                            "flag --format cannot be combined with --json, --table or --group",
+	"флаг --no-dup несовместим с --from-file":                                          "the --no-dup flag cannot be combined with --from-file",
+	"флаг --status несовместим с --todo, --in-progress и --done":                       "the --status flag is incompatible with --todo, --in-progress and --done",
+	"флаг -q несовместим с --json, --table, --group и --format":                        "the -q flag cannot be combined with --json, --table, --group and --format",
+	"флаги --group и --json несовместимы":                                              "flags --group and --json cannot be combined",
+	"ч":      "h",
+	"час":    "hour",
+	"часа":   "hours",
+	"часов":  "hours",
+	"через ": "in ",
+}
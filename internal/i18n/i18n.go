@@ -0,0 +1,38 @@
+package i18n
+
+import "os"
+
+var lang = os.Getenv("TASK_CLI_LANG")
+
+func T(message string) string {
+	if lang == "en" {
+		if translated, ok := en[message]; ok {
+			return translated
+		}
+	}
+
+	return message
+}
+
+func Plural(n int, one, few, many string) string {
+	if lang == "en" {
+		if n == 1 {
+			return T(one)
+		}
+		return T(many)
+	}
+
+	n %= 100
+	if n >= 11 && n <= 14 {
+		return many
+	}
+
+	switch n % 10 {
+	case 1:
+		return one
+	case 2, 3, 4:
+		return few
+	default:
+		return many
+	}
+}
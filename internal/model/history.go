@@ -0,0 +1,19 @@
+package model
+
+type HistoryAction string
+
+const (
+	ActionAdd    HistoryAction = "add"
+	ActionUpdate HistoryAction = "update"
+	ActionMark   HistoryAction = "mark"
+	ActionDelete HistoryAction = "delete"
+	ActionUndo   HistoryAction = "undo"
+)
+
+type HistoryEntry struct {
+	Time   string        `json:"time"`
+	Action HistoryAction `json:"action"`
+	TaskId int           `json:"task_id"`
+	Before *Task         `json:"before,omitempty"`
+	After  *Task         `json:"after,omitempty"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+func JoinIds(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(parts, ", ")
+}
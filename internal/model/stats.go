@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type TaskStats struct {
+	Total             int                `json:"total"`
+	ByStatus          map[TaskStatus]int `json:"by_status"`
+	DonePercent       float64            `json:"done_percent"`
+	AverageCompletion time.Duration      `json:"-"`
+	CompletedTimed    int                `json:"-"`
+}
+
+type Progress struct {
+	Done  int
+	Total int
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"slices"
+	"time"
+)
+
 type TaskStatus string
 
 const (
@@ -8,10 +13,81 @@ const (
 	StatusDone       TaskStatus = "done"
 )
 
+var BuiltinStatuses = []TaskStatus{StatusTodo, StatusInProgress, StatusDone}
+
+var Statuses = slices.Clone(BuiltinStatuses)
+
+func IsValidStatus(status TaskStatus) bool {
+	return slices.Contains(Statuses, status)
+}
+
+type TaskPriority string
+
+const (
+	PriorityLow    TaskPriority = "low"
+	PriorityMedium TaskPriority = "medium"
+	PriorityHigh   TaskPriority = "high"
+)
+
+var Priorities = []TaskPriority{PriorityHigh, PriorityMedium, PriorityLow}
+
+func PriorityRank(priority TaskPriority) int {
+	if rank := slices.Index(Priorities, priority); rank != -1 {
+		return rank
+	}
+
+	return len(Priorities)
+}
+
 type Task struct {
-	Id          int        `json:"id"`
-	Description string     `json:"description"`
-	Status      TaskStatus `json:"status"`
-	CreatedAt   string     `json:"created_at"`
-	UpdatedAt   string     `json:"updated_at"`
+	Id          int          `json:"id"`
+	Description string       `json:"description"`
+	Status      TaskStatus   `json:"status"`
+	Priority    TaskPriority `json:"priority"`
+	CreatedAt   string       `json:"created_at"`
+	UpdatedAt   string       `json:"updated_at"`
+	CompletedAt string       `json:"completed_at,omitempty"`
+	DueDate     string       `json:"due_date,omitempty"`
+	Tags        []string     `json:"tags,omitempty"`
+	Archived    bool         `json:"archived,omitempty"`
+	Order       int          `json:"order,omitempty"`
+	Deleted     bool         `json:"deleted,omitempty"`
+	DeletedAt   string       `json:"deleted_at,omitempty"`
+	ParentId    int          `json:"parent_id,omitempty"`
+	DependsOn   []int        `json:"depends_on,omitempty"`
+	TimeEntries []Interval   `json:"time_entries,omitempty"`
+	Assignee    string       `json:"assignee,omitempty"`
+	Notes       string       `json:"notes,omitempty"`
+	Pinned      bool         `json:"pinned,omitempty"`
+}
+
+type Interval struct {
+	Start string `json:"start"`
+	End   string `json:"end,omitempty"`
+}
+
+type DeleteOptions struct {
+	Hard    bool
+	Cascade bool
+}
+
+type SearchOptions struct {
+	Exact  bool
+	Regexp bool
+}
+
+type TaskFilter struct {
+	Status          TaskStatus
+	Statuses        []TaskStatus
+	Tag             string
+	Assignee        string
+	Archived        bool
+	IncludeArchived bool
+	Blocked         bool
+	Pinned          bool
+	Since           time.Time
+	Sort            string
+	Reverse         bool
+	Limit           int
+	Offset          int
 }
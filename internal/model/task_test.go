@@ -0,0 +1,22 @@
+package model
+
+import "testing"
+
+func TestPriorityRank(t *testing.T) {
+	tests := []struct {
+		priority TaskPriority
+		want     int
+	}{
+		{PriorityHigh, 0},
+		{PriorityMedium, 1},
+		{PriorityLow, 2},
+		{"urgent", 3},
+		{"", 3},
+	}
+
+	for _, tt := range tests {
+		if got := PriorityRank(tt.priority); got != tt.want {
+			t.Errorf("PriorityRank(%q) = %d, want %d", tt.priority, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"errors"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+)
+
+type Store interface {
+	LoadTasks() ([]model.Task, error)
+	SaveTasks(tasks []model.Task) error
+	RestoreBackup() error
+	LastId() (int, error)
+}
+
+type canonicalChecker interface {
+	IsCanonical() (bool, error)
+}
+
+func isCanonical(store Store) (bool, error) {
+	checker, ok := store.(canonicalChecker)
+	if !ok {
+		return false, errors.New(i18n.T("проверка формата поддерживается только для хранилища json"))
+	}
+
+	return checker.IsCanonical()
+}
+
+type cachedTaskRepository struct {
+	store  Store
+	memory *memoryTaskRepository
+	dirty  bool
+}
+
+func NewCachedTaskRepository(store Store) *cachedTaskRepository {
+	return &cachedTaskRepository{store: store}
+}
+
+func (r *cachedTaskRepository) load() error {
+	if r.memory != nil {
+		return nil
+	}
+
+	tasks, err := r.store.LoadTasks()
+	if err != nil {
+		return err
+	}
+	lastId, err := r.store.LastId()
+	if err != nil {
+		return err
+	}
+
+	r.memory = NewMemoryTaskRepository(tasks)
+	r.memory.lastId = lastId
+	return nil
+}
+
+func (r *cachedTaskRepository) LoadTasks() ([]model.Task, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.memory.LoadTasks()
+}
+
+func (r *cachedTaskRepository) SaveTasks(tasks []model.Task) error {
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	r.dirty = true
+	return r.memory.SaveTasks(tasks)
+}
+
+func (r *cachedTaskRepository) RestoreBackup() error {
+	if r.memory == nil || (!r.dirty && r.memory.backup == nil) {
+		if err := r.store.RestoreBackup(); err != nil {
+			return err
+		}
+		r.memory = nil
+		return nil
+	}
+
+	if err := r.memory.RestoreBackup(); err != nil {
+		return err
+	}
+	r.dirty = true
+	return nil
+}
+
+func (r *cachedTaskRepository) IsCanonical() (bool, error) {
+	return isCanonical(r.store)
+}
+
+func (r *cachedTaskRepository) LastId() (int, error) {
+	if err := r.load(); err != nil {
+		return 0, err
+	}
+
+	return r.memory.LastId()
+}
+
+func (r *cachedTaskRepository) Flush() error {
+	if !r.dirty {
+		return nil
+	}
+
+	tasks, err := r.memory.LoadTasks()
+	if err != nil {
+		return err
+	}
+	if err := r.store.SaveTasks(tasks); err != nil {
+		return err
+	}
+
+	r.dirty = false
+	return nil
+}
@@ -0,0 +1,23 @@
+package repository
+
+import "go-task-cli/internal/model"
+
+type dryRunTaskRepository struct {
+	Store
+}
+
+func NewDryRunTaskRepository(store Store) *dryRunTaskRepository {
+	return &dryRunTaskRepository{Store: store}
+}
+
+func (r *dryRunTaskRepository) SaveTasks(tasks []model.Task) error {
+	return nil
+}
+
+func (r *dryRunTaskRepository) RestoreBackup() error {
+	return nil
+}
+
+func (r *dryRunTaskRepository) IsCanonical() (bool, error) {
+	return isCanonical(r.Store)
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"os"
+	"reflect"
+	"time"
+)
+
+type historyTaskRepository struct {
+	Store
+	logFile string
+	warn    func(error)
+}
+
+func NewHistoryTaskRepository(store Store, logFile string, warn func(error)) *historyTaskRepository {
+	return &historyTaskRepository{Store: store, logFile: logFile, warn: warn}
+}
+
+func HistoryFile(tasksFile string) string {
+	return tasksFile + ".log"
+}
+
+func (r *historyTaskRepository) SaveTasks(tasks []model.Task) error {
+	before, loadErr := r.Store.LoadTasks()
+	if err := r.Store.SaveTasks(tasks); err != nil {
+		return err
+	}
+	if loadErr == nil {
+		r.record(diffTasks(before, tasks, ""))
+	}
+
+	return nil
+}
+
+func (r *historyTaskRepository) RestoreBackup() error {
+	before, loadErr := r.Store.LoadTasks()
+	if err := r.Store.RestoreBackup(); err != nil {
+		return err
+	}
+	if loadErr != nil {
+		return nil
+	}
+
+	after, err := r.Store.LoadTasks()
+	if err == nil {
+		r.record(diffTasks(before, after, model.ActionUndo))
+	}
+
+	return nil
+}
+
+func (r *historyTaskRepository) IsCanonical() (bool, error) {
+	return isCanonical(r.Store)
+}
+
+func (r *historyTaskRepository) record(entries []model.HistoryEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	var b bytes.Buffer
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			r.warn(fmt.Errorf(i18n.T("ошибка сериализации истории: %v"), err))
+			return
+		}
+		b.Write(data)
+		b.WriteByte('\n')
+	}
+
+	f, err := os.OpenFile(r.logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		r.warn(fmt.Errorf(i18n.T("ошибка записи истории: %v"), err))
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(b.Bytes()); err != nil {
+		r.warn(fmt.Errorf(i18n.T("ошибка записи истории: %v"), err))
+	}
+}
+
+func diffTasks(before, after []model.Task, action model.HistoryAction) []model.HistoryEntry {
+	now := time.Now().UTC().Format(time.RFC3339)
+	previous := make(map[int]model.Task, len(before))
+	for _, task := range before {
+		previous[task.Id] = task
+	}
+
+	var entries []model.HistoryEntry
+	for _, task := range after {
+		old, ok := previous[task.Id]
+		delete(previous, task.Id)
+		if ok && reflect.DeepEqual(old, task) {
+			continue
+		}
+
+		entry := model.HistoryEntry{Time: now, Action: action, TaskId: task.Id, After: &task}
+		if ok {
+			entry.Before = &old
+		}
+		if entry.Action == "" {
+			entry.Action = changeAction(entry.Before, task)
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, task := range before {
+		if _, ok := previous[task.Id]; ok {
+			entry := model.HistoryEntry{Time: now, Action: action, TaskId: task.Id, Before: &task}
+			if entry.Action == "" {
+				entry.Action = model.ActionDelete
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func changeAction(before *model.Task, after model.Task) model.HistoryAction {
+	switch {
+	case before == nil:
+		return model.ActionAdd
+	case after.Deleted && !before.Deleted:
+		return model.ActionDelete
+	case after.Status != before.Status:
+		return model.ActionMark
+	default:
+		return model.ActionUpdate
+	}
+}
+
+func ReadHistory(logFile string) ([]model.HistoryEntry, error) {
+	f, err := os.Open(logFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf(i18n.T("ошибка чтения истории: %v"), err)
+	}
+	defer f.Close()
+
+	var entries []model.HistoryEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var entry model.HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf(i18n.T("ошибка парсинга истории в строке %d: %v"), line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка чтения истории: %v"), err)
+	}
+
+	return entries, nil
+}
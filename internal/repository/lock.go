@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"os"
+	"strconv"
+	"time"
+)
+
+const lockRetryInterval = 50 * time.Millisecond
+
+func Lock(tasksFile string, timeout time.Duration) (func(), error) {
+	lockFile := tasksFile + ".lock"
+	deadline := time.Now().Add(timeout)
+
+	for {
+		file, err := os.OpenFile(lockFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			file.WriteString(strconv.Itoa(os.Getpid()))
+			file.Close()
+			return func() { os.Remove(lockFile) }, nil
+		}
+
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf(i18n.T("ошибка блокировки файла задач: %v"), err)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf(i18n.T("файл задач занят другим процессом (если это не так, удалите %s)"), lockFile)
+		}
+
+		time.Sleep(lockRetryInterval)
+	}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"go-task-cli/internal/model"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockSerializesWriters(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	const writers, adds = 2, 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*adds)
+	for range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range adds {
+				errs <- addLocked(file)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tasks, err := NewTaskRepository(file, false, func(error) {}).LoadTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != writers*adds {
+		t.Errorf("got %d tasks, want %d", len(tasks), writers*adds)
+	}
+}
+
+func TestLockTimeout(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	unlock, err := Lock(file, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	if _, err := Lock(file, 100*time.Millisecond); err == nil {
+		t.Error("second Lock: expected timeout error")
+	}
+}
+
+func addLocked(file string) error {
+	unlock, err := Lock(file, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	repo := NewTaskRepository(file, false, func(error) {})
+	tasks, err := repo.LoadTasks()
+	if err != nil {
+		return err
+	}
+	tasks = append(tasks, model.Task{Id: len(tasks) + 1, Description: "task", Status: model.StatusTodo})
+
+	return repo.SaveTasks(tasks)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"errors"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"slices"
+)
+
+type memoryTaskRepository struct {
+	tasks  []model.Task
+	backup []model.Task
+	lastId int
+}
+
+func NewMemoryTaskRepository(tasks []model.Task) *memoryTaskRepository {
+	return &memoryTaskRepository{tasks: cloneTasks(tasks)}
+}
+
+func (r *memoryTaskRepository) LoadTasks() ([]model.Task, error) {
+	return cloneTasks(r.tasks), nil
+}
+
+func (r *memoryTaskRepository) SaveTasks(tasks []model.Task) error {
+	r.backup = r.tasks
+	r.tasks = cloneTasks(tasks)
+	for _, task := range tasks {
+		r.lastId = max(r.lastId, task.Id)
+	}
+	return nil
+}
+
+func (r *memoryTaskRepository) RestoreBackup() error {
+	if r.backup == nil {
+		return errors.New(i18n.T("нет сохраненного состояния для отмены"))
+	}
+
+	r.tasks, r.backup = r.backup, nil
+	return nil
+}
+
+func (r *memoryTaskRepository) LastId() (int, error) {
+	return r.lastId, nil
+}
+
+func cloneTasks(tasks []model.Task) []model.Task {
+	if tasks == nil {
+		return []model.Task{}
+	}
+
+	cloned := make([]model.Task, len(tasks))
+	for i, task := range tasks {
+		task.Tags = slices.Clone(task.Tags)
+		task.DependsOn = slices.Clone(task.DependsOn)
+		task.TimeEntries = slices.Clone(task.TimeEntries)
+		cloned[i] = task
+	}
+
+	return cloned
+}
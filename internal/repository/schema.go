@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"unicode/utf8"
+)
+
+const (
+	schemaVersion = 1
+	snippetRadius = 20
+)
+
+type tasksEnvelope struct {
+	Version int             `json:"version"`
+	Tasks   json.RawMessage `json:"tasks"`
+}
+
+var migrations = map[int]func(json.RawMessage) (json.RawMessage, error){
+	0: migrateBareArray,
+}
+
+func decodeTasks(data []byte) ([]model.Task, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+
+	var envelope tasksEnvelope
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		envelope = tasksEnvelope{Version: 0, Tasks: data}
+	} else if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	} else if envelope.Version < 1 {
+		return nil, fmt.Errorf(i18n.T("неверная версия формата файла задач %d"), envelope.Version)
+	}
+	if envelope.Version > schemaVersion {
+		return nil, fmt.Errorf(i18n.T("версия формата файла задач %d новее поддерживаемой (%d), обновите task-cli"), envelope.Version, schemaVersion)
+	}
+
+	for version := envelope.Version; version < schemaVersion; version++ {
+		upgraded, err := migrations[version](envelope.Tasks)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ошибка миграции с версии %d: %v"), version, err)
+		}
+		envelope.Tasks = upgraded
+	}
+
+	var tasks []model.Task
+	if len(envelope.Tasks) != 0 {
+		if err := json.Unmarshal(envelope.Tasks, &tasks); err != nil {
+			return nil, err
+		}
+	}
+
+	return tasks, nil
+}
+
+func migrateBareArray(tasks json.RawMessage) (json.RawMessage, error) {
+	return tasks, nil
+}
+
+func encodeTasks(tasks []model.Task, compact bool) ([]byte, error) {
+	if tasks == nil {
+		tasks = []model.Task{}
+	}
+
+	envelope := struct {
+		Version int          `json:"version"`
+		Tasks   []model.Task `json:"tasks"`
+	}{schemaVersion, tasks}
+	if compact {
+		return json.Marshal(envelope)
+	}
+
+	return json.MarshalIndent(envelope, "", "  ")
+}
+
+func syntaxErrorDetail(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return err
+	}
+
+	offset := int(min(syntaxErr.Offset, int64(len(data))))
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := utf8.RuneCount(before[bytes.LastIndexByte(before, '\n')+1:]) + 1
+
+	start := max(offset-snippetRadius, 0)
+	for start > 0 && !utf8.RuneStart(data[start]) {
+		start--
+	}
+	end := min(offset+snippetRadius, len(data))
+	for end < len(data) && !utf8.RuneStart(data[end]) {
+		end++
+	}
+
+	return fmt.Errorf("%w (offset=%d line=%d column=%d near=%q)", err, offset, line, column, data[start:end])
+}
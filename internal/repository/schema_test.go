@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"encoding/json"
+	"go-task-cli/internal/model"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeTasks(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr bool
+	}{
+		{"bare array", `[{"id":1,"description":"a","status":"todo"},{"id":2,"description":"b","status":"done"}]`, 2, false},
+		{"envelope", `{"version":1,"tasks":[{"id":1,"description":"a","status":"todo"}]}`, 1, false},
+		{"empty envelope", `{"version":1,"tasks":[]}`, 0, false},
+		{"future version", `{"version":99,"tasks":[]}`, 0, true},
+		{"missing version", `{"tasks":[]}`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, err := decodeTasks([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeTasks: %v", err)
+			}
+			if len(tasks) != tt.want {
+				t.Errorf("decodeTasks returned %d tasks, want %d", len(tasks), tt.want)
+			}
+		})
+	}
+}
+
+func TestBareArrayMigratedOnSave(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	if err := os.WriteFile(file, []byte(`[{"id":1,"description":"a","status":"todo"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewTaskRepository(file, false, func(error) {})
+	tasks, err := repo.LoadTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].Description != "a" || tasks[0].Priority != model.PriorityMedium {
+		t.Fatalf("LoadTasks = %+v", tasks)
+	}
+	if err := repo.SaveTasks(tasks); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var envelope tasksEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		t.Fatalf("saved file is not an envelope: %v", err)
+	}
+	if envelope.Version != schemaVersion {
+		t.Errorf("saved version = %d, want %d", envelope.Version, schemaVersion)
+	}
+}
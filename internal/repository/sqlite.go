@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+type sqliteColumn struct {
+	name  string
+	decl  string
+	field func(task *model.Task) any
+}
+
+var sqliteColumns = []sqliteColumn{
+	{"id", "INTEGER PRIMARY KEY", func(t *model.Task) any { return &t.Id }},
+	{"description", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.Description }},
+	{"status", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.Status }},
+	{"priority", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.Priority }},
+	{"created_at", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.CreatedAt }},
+	{"updated_at", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.UpdatedAt }},
+	{"completed_at", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.CompletedAt }},
+	{"due_date", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.DueDate }},
+	{"tags", "TEXT NOT NULL DEFAULT 'null'", func(t *model.Task) any { return jsonColumn{&t.Tags} }},
+	{"archived", "INTEGER NOT NULL DEFAULT 0", func(t *model.Task) any { return &t.Archived }},
+	{"sort_order", "INTEGER NOT NULL DEFAULT 0", func(t *model.Task) any { return &t.Order }},
+	{"deleted", "INTEGER NOT NULL DEFAULT 0", func(t *model.Task) any { return &t.Deleted }},
+	{"deleted_at", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.DeletedAt }},
+	{"parent_id", "INTEGER NOT NULL DEFAULT 0", func(t *model.Task) any { return &t.ParentId }},
+	{"depends_on", "TEXT NOT NULL DEFAULT 'null'", func(t *model.Task) any { return jsonColumn{&t.DependsOn} }},
+	{"time_entries", "TEXT NOT NULL DEFAULT 'null'", func(t *model.Task) any { return jsonColumn{&t.TimeEntries} }},
+	{"assignee", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.Assignee }},
+	{"notes", "TEXT NOT NULL DEFAULT ''", func(t *model.Task) any { return &t.Notes }},
+	{"pinned", "INTEGER NOT NULL DEFAULT 0", func(t *model.Task) any { return &t.Pinned }},
+}
+
+type jsonColumn struct {
+	value any
+}
+
+func (c jsonColumn) Value() (driver.Value, error) {
+	data, err := json.Marshal(c.value)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (c jsonColumn) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(src), c.value)
+	case []byte:
+		return json.Unmarshal(src, c.value)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf(i18n.T("неожиданный тип значения %T"), src)
+	}
+}
+
+type sqliteTaskRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteTaskRepository(dbFile string) (*sqliteTaskRepository, error) {
+	db, err := sql.Open("sqlite", dbFile)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка открытия базы задач: %v"), err)
+	}
+
+	r := &sqliteTaskRepository{db: db}
+	if err := r.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *sqliteTaskRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *sqliteTaskRepository) LoadTasks() ([]model.Task, error) {
+	rows, err := r.db.Query(fmt.Sprintf("SELECT %s FROM tasks ORDER BY position", columnNames()))
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %v"), err)
+	}
+	defer rows.Close()
+
+	var tasks []model.Task
+	for rows.Next() {
+		var task model.Task
+		dest := make([]any, len(sqliteColumns))
+		for i, column := range sqliteColumns {
+			dest[i] = column.field(&task)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf(i18n.T("ошибка чтения задачи: %v"), err)
+		}
+		if task.Priority == "" {
+			task.Priority = model.PriorityMedium
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %v"), err)
+	}
+
+	return tasks, nil
+}
+
+func (r *sqliteTaskRepository) SaveTasks(tasks []model.Task) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи задач: %v"), err)
+	}
+	defer tx.Rollback()
+
+	names := columnNames()
+	statements := []string{
+		"DELETE FROM tasks_backup",
+		fmt.Sprintf("INSERT INTO tasks_backup (position, %s) SELECT position, %s FROM tasks", names, names),
+		"INSERT OR REPLACE INTO meta (key, value) VALUES ('has_backup', 1)",
+		"DELETE FROM tasks",
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return fmt.Errorf(i18n.T("ошибка записи задач: %v"), err)
+		}
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(sqliteColumns)+1), ", ")
+	insert, err := tx.Prepare(fmt.Sprintf("INSERT INTO tasks (position, %s) VALUES (%s)", names, placeholders))
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи задач: %v"), err)
+	}
+	defer insert.Close()
+
+	lastId := 0
+	for i, task := range tasks {
+		values := []any{i}
+		for _, column := range sqliteColumns {
+			values = append(values, column.field(&task))
+		}
+
+		if _, err := insert.Exec(values...); err != nil {
+			return fmt.Errorf(i18n.T("ошибка записи задачи с ID %d: %v"), task.Id, err)
+		}
+		lastId = max(lastId, task.Id)
+	}
+
+	_, err = tx.Exec("INSERT INTO meta (key, value) VALUES ('last_id', ?) ON CONFLICT (key) DO UPDATE SET value = max(value, excluded.value)", lastId)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи счетчика ID: %v"), err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи задач: %v"), err)
+	}
+
+	return nil
+}
+
+func (r *sqliteTaskRepository) RestoreBackup() error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка восстановления резервной копии: %v"), err)
+	}
+	defer tx.Rollback()
+
+	var hasBackup int
+	err = tx.QueryRow("SELECT value FROM meta WHERE key = 'has_backup'").Scan(&hasBackup)
+	if err == sql.ErrNoRows || (err == nil && hasBackup == 0) {
+		return errors.New(i18n.T("нет сохраненного состояния для отмены"))
+	}
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка восстановления резервной копии: %v"), err)
+	}
+
+	names := columnNames()
+	statements := []string{
+		"DELETE FROM tasks",
+		fmt.Sprintf("INSERT INTO tasks (position, %s) SELECT position, %s FROM tasks_backup", names, names),
+		"DELETE FROM tasks_backup",
+		"UPDATE meta SET value = 0 WHERE key = 'has_backup'",
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return fmt.Errorf(i18n.T("ошибка восстановления резервной копии: %v"), err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(i18n.T("ошибка восстановления резервной копии: %v"), err)
+	}
+
+	return nil
+}
+
+func (r *sqliteTaskRepository) LastId() (int, error) {
+	var lastId int
+	err := r.db.QueryRow("SELECT value FROM meta WHERE key = 'last_id'").Scan(&lastId)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка чтения счетчика ID: %v"), err)
+	}
+
+	return lastId, nil
+}
+
+func (r *sqliteTaskRepository) migrate() error {
+	_, err := r.db.Exec("CREATE TABLE IF NOT EXISTS meta (key TEXT PRIMARY KEY, value INTEGER NOT NULL)")
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка создания схемы базы задач: %v"), err)
+	}
+
+	for _, table := range []string{"tasks", "tasks_backup"} {
+		if err := r.migrateTable(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *sqliteTaskRepository) migrateTable(table string) error {
+	decls := []string{"position INTEGER NOT NULL DEFAULT 0"}
+	for _, column := range sqliteColumns {
+		decls = append(decls, column.name+" "+column.decl)
+	}
+
+	_, err := r.db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", table, strings.Join(decls, ", ")))
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка создания схемы базы задач: %v"), err)
+	}
+
+	rows, err := r.db.Query(fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка чтения схемы базы задач: %v"), err)
+	}
+	existing := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf(i18n.T("ошибка чтения схемы базы задач: %v"), err)
+		}
+		existing[name] = true
+	}
+	rows.Close()
+
+	for _, column := range sqliteColumns {
+		if existing[column.name] {
+			continue
+		}
+
+		_, err := r.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column.name, column.decl))
+		if err != nil {
+			return fmt.Errorf(i18n.T("ошибка обновления схемы базы задач: %v"), err)
+		}
+	}
+
+	return nil
+}
+
+func columnNames() string {
+	names := make([]string, len(sqliteColumns))
+	for i, column := range sqliteColumns {
+		names[i] = column.name
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -1,50 +1,257 @@
 package repository
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"go-task-cli/internal/i18n"
 	"go-task-cli/internal/model"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 )
 
+var gzipMagic = []byte{0x1f, 0x8b}
+
 type taskRepository struct {
 	tasksFile string
+	compact   bool
+	warn      func(error)
+	warned    bool
 }
 
-func NewTaskRepository(tasksFile string) *taskRepository {
-	return &taskRepository{tasksFile: tasksFile}
+func NewTaskRepository(tasksFile string, compact bool, warn func(error)) *taskRepository {
+	return &taskRepository{tasksFile: tasksFile, compact: compact, warn: warn}
 }
 
 func (r *taskRepository) LoadTasks() ([]model.Task, error) {
-	var tasks []model.Task
-
 	data, err := os.ReadFile(r.tasksFile)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
 		}
 
-		return nil, fmt.Errorf("ошибка загрузки задач: %v", err)
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %v"), err)
 	}
 
-	err = json.Unmarshal(data, &tasks)
+	data, err = decompress(data)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка парсинга файла задач: %v", err)
+		return nil, fmt.Errorf(i18n.T("ошибка распаковки файла задач: %v"), err)
+	}
+
+	tasks, err := decodeTasks(data)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка парсинга файла задач: %v"), syntaxErrorDetail(data, err))
+	}
+
+	for i := range tasks {
+		if tasks[i].Priority == "" {
+			tasks[i].Priority = model.PriorityMedium
+		}
+	}
+	if ids := duplicateIds(tasks); len(ids) != 0 && !r.warned {
+		r.warned = true
+		r.warn(fmt.Errorf(i18n.T("в файле задач повторяются ID %s, исправьте их командой doctor --fix"), ids))
 	}
 
 	return tasks, nil
 }
 
 func (r *taskRepository) SaveTasks(tasks []model.Task) error {
-	data, err := json.MarshalIndent(tasks, "", "  ")
+	data, err := encodeTasks(tasks, r.compact)
 	if err != nil {
-		return fmt.Errorf("ошибка сериализации задач: %v", err)
+		return fmt.Errorf(i18n.T("ошибка сериализации задач: %v"), err)
+	}
+
+	if r.compressed() {
+		if data, err = compress(data); err != nil {
+			return fmt.Errorf(i18n.T("ошибка сжатия файла задач: %v"), err)
+		}
+	}
+
+	if err := r.backupTasks(); err != nil {
+		return err
 	}
 
-	err = os.WriteFile(r.tasksFile, data, 0644)
+	tmpFile := r.tasksFile + ".tmp"
+	err = os.WriteFile(tmpFile, data, 0644)
 	if err != nil {
-		return fmt.Errorf("ошибка записи файла задач: %v", err)
+		os.Remove(tmpFile)
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %v"), err)
+	}
+
+	err = os.Rename(tmpFile, r.tasksFile)
+	if err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %v"), err)
+	}
+
+	return r.saveLastId(tasks)
+}
+
+func (r *taskRepository) IsCanonical() (bool, error) {
+	data, err := os.ReadFile(r.tasksFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf(i18n.T("ошибка загрузки задач: %v"), err)
+	}
+
+	if data, err = decompress(data); err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка распаковки файла задач: %v"), err)
+	}
+
+	tasks, err := r.LoadTasks()
+	if err != nil {
+		return false, err
+	}
+	canonical, err := encodeTasks(tasks, r.compact)
+	if err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка сериализации задач: %v"), err)
+	}
+
+	var onDisk, expected bytes.Buffer
+	if err := json.Compact(&onDisk, data); err != nil {
+		return false, nil
+	}
+	if err := json.Compact(&expected, canonical); err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка сериализации задач: %v"), err)
+	}
+
+	return bytes.Equal(onDisk.Bytes(), expected.Bytes()), nil
+}
+
+func duplicateIds(tasks []model.Task) string {
+	seen := make(map[int]int, len(tasks))
+	var ids []string
+	for _, task := range tasks {
+		seen[task.Id]++
+		if seen[task.Id] == 2 {
+			ids = append(ids, strconv.Itoa(task.Id))
+		}
+	}
+
+	return strings.Join(ids, ", ")
+}
+
+func (r *taskRepository) LastId() (int, error) {
+	data, err := os.ReadFile(r.sequenceFile())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf(i18n.T("ошибка чтения счетчика ID: %v"), err)
+	}
+
+	id, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка парсинга счетчика ID: %v"), err)
+	}
+
+	return id, nil
+}
+
+func (r *taskRepository) saveLastId(tasks []model.Task) error {
+	lastId, err := r.LastId()
+	if err != nil {
+		return err
+	}
+
+	id := lastId
+	for _, task := range tasks {
+		id = max(id, task.Id)
+	}
+	if id == lastId {
+		return nil
+	}
+
+	err = os.WriteFile(r.sequenceFile(), []byte(strconv.Itoa(id)+"\n"), 0644)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи счетчика ID: %v"), err)
+	}
+
+	return nil
+}
+
+func (r *taskRepository) sequenceFile() string {
+	return r.tasksFile + ".seq"
+}
+
+func (r *taskRepository) RestoreBackup() error {
+	err := os.Rename(r.backupFile(), r.tasksFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.New(i18n.T("нет сохраненного состояния для отмены"))
+		}
+
+		return fmt.Errorf(i18n.T("ошибка восстановления резервной копии: %v"), err)
+	}
+
+	return nil
+}
+
+func (r *taskRepository) backupTasks() error {
+	data, err := os.ReadFile(r.tasksFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf(i18n.T("ошибка создания резервной копии: %v"), err)
+		}
+		data = []byte("[]")
+	}
+
+	plain, err := decompress(data)
+	if err != nil {
+		return nil
+	}
+	if _, err := decodeTasks(plain); err != nil {
+		return nil
+	}
+
+	err = os.WriteFile(r.backupFile(), data, 0644)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка создания резервной копии: %v"), err)
 	}
 
 	return nil
 }
+
+func (r *taskRepository) backupFile() string {
+	return r.tasksFile + ".bak"
+}
+
+func (r *taskRepository) compressed() bool {
+	return strings.HasSuffix(r.tasksFile, ".gz")
+}
+
+func compress(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"bytes"
+	"go-task-cli/internal/model"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestIsCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want bool
+	}{
+		{"indented", "{\n  \"version\": 1,\n  \"tasks\": [\n    {\n      \"id\": 1,\n      \"description\": \"a\",\n      \"status\": \"todo\",\n      \"priority\": \"medium\",\n      \"created_at\": \"\",\n      \"updated_at\": \"\"\n    }\n  ]\n}\n", true},
+		{"compact", `{"version":1,"tasks":[{"id":1,"description":"a","status":"todo","priority":"medium","created_at":"","updated_at":""}]}`, true},
+		{"reordered keys", `{"tasks":[{"id":1,"description":"a","status":"todo","priority":"medium","created_at":"","updated_at":""}],"version":1}`, false},
+		{"bare array", `[{"id":1,"description":"a","status":"todo","priority":"medium","created_at":"","updated_at":""}]`, false},
+		{"missing default priority", `{"version":1,"tasks":[{"id":1,"description":"a","status":"todo","created_at":"","updated_at":""}]}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "tasks.json")
+			if err := os.WriteFile(file, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, compact := range []bool{false, true} {
+				got, err := NewTaskRepository(file, compact, func(error) {}).IsCanonical()
+				if err != nil {
+					t.Fatalf("IsCanonical: %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("IsCanonical(compact=%v) = %v, want %v", compact, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsCanonicalAfterSave(t *testing.T) {
+	for _, compact := range []bool{false, true} {
+		file := filepath.Join(t.TempDir(), "tasks.json")
+		repo := NewTaskRepository(file, compact, func(error) {})
+		if err := repo.SaveTasks([]model.Task{{Id: 1, Description: "a", Status: model.StatusTodo, Priority: model.PriorityMedium}}); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := NewTaskRepository(file, !compact, func(error) {}).IsCanonical()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got {
+			t.Errorf("file saved with compact=%v is not canonical", compact)
+		}
+	}
+}
+
+func TestSaveTasksFailedWriteKeepsOriginal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	repo := NewTaskRepository(file, false, func(error) {})
+	if err := repo.SaveTasks([]model.Task{{Id: 1, Description: "a", Status: model.StatusTodo}}); err != nil {
+		t.Fatal(err)
+	}
+	original, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(file+".tmp", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SaveTasks([]model.Task{{Id: 2, Description: "b", Status: model.StatusTodo}}); err == nil {
+		t.Fatal("expected SaveTasks to fail")
+	}
+
+	got, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(original) {
+		t.Errorf("tasks file changed after failed write:\n%s\nwant:\n%s", got, original)
+	}
+}
+
+func TestSaveTasksRemovesTempFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	if err := NewTaskRepository(file, false, func(error) {}).SaveTasks(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(file + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temp file left behind: %v", err)
+	}
+
+	reference := filepath.Join(filepath.Dir(file), "reference")
+	if err := os.WriteFile(reference, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.Stat(reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != want.Mode().Perm() {
+		t.Errorf("tasks file mode = %v, want %v", info.Mode().Perm(), want.Mode().Perm())
+	}
+}
+
+func TestBackupSkipsCorruptFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	repo := NewTaskRepository(file, false, func(error) {})
+	if err := repo.SaveTasks([]model.Task{{Id: 1, Description: "a", Status: model.StatusTodo}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SaveTasks([]model.Task{{Id: 1, Description: "b", Status: model.StatusTodo}}); err != nil {
+		t.Fatal(err)
+	}
+	good, err := os.ReadFile(file + ".bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file, []byte("{broken"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SaveTasks(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	backup, err := os.ReadFile(file + ".bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(backup) != string(good) {
+		t.Errorf("backup overwritten by corrupt file:\n%s", backup)
+	}
+}
+
+func TestGzipRoundTrip(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json.gz")
+	repo := NewTaskRepository(file, false, func(error) {})
+	want := []model.Task{
+		{Id: 1, Description: "a", Status: model.StatusTodo, Priority: model.PriorityHigh, Tags: []string{"work"}},
+		{Id: 2, Description: "b", Status: model.StatusDone, Priority: model.PriorityLow},
+	}
+	if err := repo.SaveTasks(want); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		t.Fatalf("file is not gzip-compressed: %q", data[:min(len(data), 16)])
+	}
+
+	got, err := NewTaskRepository(file, false, func(error) {}).LoadTasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadTasks = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlainFileNotCompressed(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	if err := NewTaskRepository(file, false, func(error) {}).SaveTasks(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		t.Error("plain tasks file is gzip-compressed")
+	}
+}
+
+func TestCompactRoundTrip(t *testing.T) {
+	want := []model.Task{
+		{Id: 1, Description: "a\nb", Status: model.StatusTodo, Priority: model.PriorityHigh, Tags: []string{"work"}},
+		{Id: 2, Description: "c", Status: model.StatusDone, Priority: model.PriorityLow, DependsOn: []int{1}},
+	}
+
+	for _, compact := range []bool{false, true} {
+		file := filepath.Join(t.TempDir(), "tasks.json")
+		if err := NewTaskRepository(file, compact, func(error) {}).SaveTasks(want); err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lines := bytes.Count(data, []byte("\n")); compact && lines != 0 {
+			t.Errorf("compact file has %d newlines", lines)
+		} else if !compact && lines == 0 {
+			t.Error("indented file is a single line")
+		}
+
+		for _, reader := range []bool{false, true} {
+			got, err := NewTaskRepository(file, reader, func(error) {}).LoadTasks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("saved compact=%v, loaded compact=%v: %+v, want %+v", compact, reader, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strings"
+	"time"
+)
+
+const unassigned = "none"
+
+func (s *taskService) SetAssignee(id int, assignee string) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	task.Assignee = strings.TrimSpace(assignee)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func matchesAssignee(task model.Task, assignee string) bool {
+	switch assignee {
+	case "":
+		return true
+	case unassigned:
+		return task.Assignee == ""
+	default:
+		return strings.EqualFold(task.Assignee, assignee)
+	}
+}
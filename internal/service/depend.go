@@ -0,0 +1,81 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"slices"
+	"time"
+)
+
+func (s *taskService) AddDependency(id int, dependsOn int) error {
+	if id == dependsOn {
+		return errors.New(i18n.T("задача не может зависеть от самой себя"))
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+	if _, err := taskById(tasks, dependsOn); err != nil {
+		return err
+	}
+
+	if slices.Contains(task.DependsOn, dependsOn) {
+		return fmt.Errorf(i18n.T("задача с ID %d уже зависит от задачи с ID %d"), id, dependsOn)
+	}
+	if dependsTransitively(tasks, dependsOn, id) {
+		return fmt.Errorf(i18n.T("зависимость создает цикл: задача с ID %d уже зависит от задачи с ID %d"), dependsOn, id)
+	}
+
+	task.DependsOn = append(task.DependsOn, dependsOn)
+	slices.Sort(task.DependsOn)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func dependsTransitively(tasks []model.Task, from int, to int) bool {
+	visited := make(map[int]bool)
+	stack := []int{from}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == to {
+			return true
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		if task, err := taskById(tasks, id); err == nil {
+			stack = append(stack, task.DependsOn...)
+		}
+	}
+
+	return false
+}
+
+func pendingDependencies(tasks []model.Task, task model.Task) []int {
+	var pending []int
+	for _, id := range task.DependsOn {
+		dependency, err := taskById(tasks, id)
+		if err == nil && dependency.Status != model.StatusDone {
+			pending = append(pending, id)
+		}
+	}
+
+	return pending
+}
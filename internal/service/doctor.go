@@ -0,0 +1,87 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"time"
+)
+
+func (s *taskService) Doctor(fix bool) ([]string, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	lastId, err := s.repo.LastId()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки счетчика ID: %w"), err)
+	}
+
+	problems := diagnoseTasks(tasks)
+	if !fix || len(problems) == 0 {
+		return problems, nil
+	}
+
+	repairTasks(tasks, lastId, time.Now())
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return problems, nil
+}
+
+func diagnoseTasks(tasks []model.Task) []string {
+	var problems []string
+	seen := make(map[int]bool, len(tasks))
+	for i, task := range tasks {
+		if task.Id <= 0 {
+			problems = append(problems, fmt.Sprintf(i18n.T("задача #%d: неверный ID %d"), i+1, task.Id))
+		} else if seen[task.Id] {
+			problems = append(problems, fmt.Sprintf(i18n.T("задача #%d: повторяющийся ID %d"), i+1, task.Id))
+		}
+		seen[task.Id] = true
+
+		if !model.IsValidStatus(task.Status) {
+			problems = append(problems, fmt.Sprintf(i18n.T("задача #%d (ID %d): неверный статус %q"), i+1, task.Id, task.Status))
+		}
+		if !validTimestamp(task.CreatedAt) {
+			problems = append(problems, fmt.Sprintf(i18n.T("задача #%d (ID %d): отсутствует или неверно время создания"), i+1, task.Id))
+		}
+		if !validTimestamp(task.UpdatedAt) {
+			problems = append(problems, fmt.Sprintf(i18n.T("задача #%d (ID %d): отсутствует или неверно время обновления"), i+1, task.Id))
+		}
+	}
+
+	return problems
+}
+
+func repairTasks(tasks []model.Task, lastId int, now time.Time) {
+	timestamp := now.UTC().Format(time.RFC3339)
+	seen := make(map[int]bool, len(tasks))
+	for i := range tasks {
+		task := &tasks[i]
+		if seen[task.Id] || task.Id <= 0 {
+			task.Id = nextId(tasks, lastId)
+		}
+		seen[task.Id] = true
+
+		if !model.IsValidStatus(task.Status) {
+			task.Status = model.StatusTodo
+			task.CompletedAt = ""
+		}
+		if !validTimestamp(task.CreatedAt) {
+			task.CreatedAt = timestamp
+		}
+		if !validTimestamp(task.UpdatedAt) {
+			task.UpdatedAt = timestamp
+		}
+	}
+}
+
+func validTimestamp(value string) bool {
+	_, err := time.Parse(time.RFC3339, value)
+	return err == nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiagnoseMatchesRepair(t *testing.T) {
+	valid := "2026-01-01T00:00:00Z"
+	tests := []struct {
+		name string
+		task model.Task
+	}{
+		{"healthy", model.Task{Id: 2, Status: model.StatusTodo, CreatedAt: valid, UpdatedAt: valid}},
+		{"zero id", model.Task{Id: 0, Status: model.StatusTodo, CreatedAt: valid, UpdatedAt: valid}},
+		{"negative id", model.Task{Id: -4, Status: model.StatusTodo, CreatedAt: valid, UpdatedAt: valid}},
+		{"duplicate id", model.Task{Id: 1, Status: model.StatusTodo, CreatedAt: valid, UpdatedAt: valid}},
+		{"bad status", model.Task{Id: 2, Status: "later", CreatedAt: valid, UpdatedAt: valid}},
+		{"missing timestamps", model.Task{Id: 2, Status: model.StatusDone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := []model.Task{{Id: 1, Status: model.StatusTodo, CreatedAt: valid, UpdatedAt: valid}, tt.task}
+			problems := diagnoseTasks(tasks)
+
+			repaired := []model.Task{tasks[0], tasks[1]}
+			repairTasks(repaired, 1, time.Now())
+			changed := !reflect.DeepEqual(repaired[1], tasks[1])
+
+			if changed != (len(problems) != 0) {
+				t.Errorf("diagnoseTasks = %q, but repair changed task: %v", problems, changed)
+			}
+			if len(diagnoseTasks(repaired)) != 0 {
+				t.Errorf("problems remain after repair: %q", diagnoseTasks(repaired))
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"strings"
+	"time"
+)
+
+func (s *taskService) GetTask(id int) (*model.Task, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return taskById(tasks, id)
+}
+
+func (s *taskService) EditTask(id int, desc string, notes string) error {
+	desc, err := normalizeDescription(desc)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	task.Description = desc
+	task.Notes = strings.TrimSpace(notes)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"slices"
+	"time"
+)
+
+func (s *taskService) MergeTasks(srcId int, dstId int) error {
+	if srcId == dstId {
+		return fmt.Errorf(i18n.T("нельзя объединить задачу с ID %d с самой собой"), srcId)
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	src, err := taskById(tasks, srcId)
+	if err != nil {
+		return err
+	}
+	dst, err := taskById(tasks, dstId)
+	if err != nil {
+		return err
+	}
+
+	dst.Description = joinText(dst.Description, src.Description)
+	dst.Notes = joinText(dst.Notes, src.Notes)
+	for _, tag := range src.Tags {
+		if !slices.Contains(dst.Tags, tag) {
+			dst.Tags = append(dst.Tags, tag)
+		}
+	}
+
+	if hasAncestor(tasks, dstId, srcId) {
+		dst.ParentId = src.ParentId
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	dst.UpdatedAt = now
+	src.Deleted = true
+	src.DeletedAt = now
+	src.UpdatedAt = now
+
+	dst.DependsOn = slices.DeleteFunc(append(dst.DependsOn, src.DependsOn...), func(id int) bool {
+		return id == srcId || id == dstId
+	})
+	slices.Sort(dst.DependsOn)
+	dst.DependsOn = slices.Compact(dst.DependsOn)
+
+	for i := range tasks {
+		task := &tasks[i]
+		if task == src || task == dst {
+			continue
+		}
+		if task.ParentId == srcId {
+			task.ParentId = dstId
+		}
+		if slices.Contains(task.DependsOn, srcId) {
+			task.DependsOn = slices.DeleteFunc(task.DependsOn, func(id int) bool {
+				return id == srcId || id == dstId
+			})
+			task.DependsOn = append(task.DependsOn, dstId)
+			slices.Sort(task.DependsOn)
+		}
+	}
+
+	for _, id := range dst.DependsOn {
+		if dependsTransitively(tasks, id, dstId) {
+			return fmt.Errorf(i18n.T("объединение создает цикл: задача с ID %d зависит от задачи с ID %d"), id, dstId)
+		}
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func joinText(a, b string) string {
+	switch {
+	case b == "":
+		return a
+	case a == "":
+		return b
+	default:
+		return a + "\n" + b
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/repository"
+	"slices"
+	"testing"
+)
+
+func TestMergeTasks(t *testing.T) {
+	tests := []struct {
+		name    string
+		tasks   []model.Task
+		src     int
+		dst     int
+		check   func(t *testing.T, tasks []model.Task)
+		wantErr bool
+	}{
+		{
+			name: "child dst takes parent of src",
+			tasks: []model.Task{
+				{Id: 1, Description: "a", Status: model.StatusTodo, ParentId: 4},
+				{Id: 2, Description: "b", Status: model.StatusTodo, ParentId: 1},
+				{Id: 3, Description: "c", Status: model.StatusTodo, ParentId: 1},
+				{Id: 4, Description: "d", Status: model.StatusTodo},
+			},
+			src: 1,
+			dst: 3,
+			check: func(t *testing.T, tasks []model.Task) {
+				if got := mustTask(t, tasks, 3).ParentId; got != 4 {
+					t.Errorf("dst ParentId = %d, want 4", got)
+				}
+				if got := mustTask(t, tasks, 2).ParentId; got != 3 {
+					t.Errorf("sibling ParentId = %d, want 3", got)
+				}
+			},
+		},
+		{
+			name: "grandchild dst takes place of src",
+			tasks: []model.Task{
+				{Id: 1, Description: "root", Status: model.StatusTodo},
+				{Id: 2, Description: "mid", Status: model.StatusTodo, ParentId: 1},
+				{Id: 3, Description: "leaf", Status: model.StatusTodo, ParentId: 2},
+			},
+			src: 1,
+			dst: 3,
+			check: func(t *testing.T, tasks []model.Task) {
+				if got := mustTask(t, tasks, 3).ParentId; got != 0 {
+					t.Errorf("dst ParentId = %d, want 0", got)
+				}
+				if got := mustTask(t, tasks, 2).ParentId; got != 3 {
+					t.Errorf("mid ParentId = %d, want 3", got)
+				}
+			},
+		},
+		{
+			name: "deleted children are re-parented",
+			tasks: []model.Task{
+				{Id: 1, Description: "a", Status: model.StatusTodo},
+				{Id: 2, Description: "b", Status: model.StatusTodo},
+				{Id: 3, Description: "c", Status: model.StatusTodo, ParentId: 1, Deleted: true},
+			},
+			src: 1,
+			dst: 2,
+			check: func(t *testing.T, tasks []model.Task) {
+				for _, task := range tasks {
+					if task.Id == 3 && task.ParentId != 2 {
+						t.Errorf("deleted child ParentId = %d, want 2", task.ParentId)
+					}
+				}
+			},
+		},
+		{
+			name: "dependency cycle",
+			tasks: []model.Task{
+				{Id: 1, Description: "a", Status: model.StatusTodo, DependsOn: []int{3}},
+				{Id: 2, Description: "b", Status: model.StatusTodo},
+				{Id: 3, Description: "c", Status: model.StatusTodo, DependsOn: []int{2}},
+			},
+			src:     1,
+			dst:     2,
+			wantErr: true,
+		},
+		{
+			name: "dependencies deduplicated without self reference",
+			tasks: []model.Task{
+				{Id: 1, Description: "a", Status: model.StatusTodo, DependsOn: []int{2, 3}},
+				{Id: 2, Description: "b", Status: model.StatusTodo, DependsOn: []int{3, 4}},
+				{Id: 3, Description: "c", Status: model.StatusTodo},
+				{Id: 4, Description: "d", Status: model.StatusTodo},
+				{Id: 5, Description: "e", Status: model.StatusTodo, DependsOn: []int{1, 2}},
+			},
+			src: 1,
+			dst: 2,
+			check: func(t *testing.T, tasks []model.Task) {
+				if got := mustTask(t, tasks, 2).DependsOn; !slices.Equal(got, []int{3, 4}) {
+					t.Errorf("dst DependsOn = %v, want [3 4]", got)
+				}
+				if got := mustTask(t, tasks, 5).DependsOn; !slices.Equal(got, []int{2}) {
+					t.Errorf("dependent DependsOn = %v, want [2]", got)
+				}
+				if !mustTask(t, tasks, 1).Deleted {
+					t.Error("src is not deleted")
+				}
+			},
+		},
+		{
+			name: "self merge",
+			tasks: []model.Task{
+				{Id: 1, Description: "a", Status: model.StatusTodo},
+			},
+			src:     1,
+			dst:     1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repository.NewMemoryTaskRepository(tt.tasks)
+			err := NewTaskService(repo).MergeTasks(tt.src, tt.dst)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeTasks(%d, %d): %v", tt.src, tt.dst, err)
+			}
+
+			tasks, _ := repo.LoadTasks()
+			tt.check(t, tasks)
+		})
+	}
+}
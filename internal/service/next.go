@@ -0,0 +1,81 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"slices"
+	"time"
+)
+
+func (s *taskService) NextTask() (*model.Task, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return pickNext(tasks), nil
+}
+
+func (s *taskService) TopTasks(n int) ([]model.Task, error) {
+	if n < 1 {
+		return nil, fmt.Errorf(i18n.T("количество задач должно быть положительным: %d"), n)
+	}
+
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return pickTop(tasks, n), nil
+}
+
+func pickNext(tasks []model.Task) *model.Task {
+	top := pickTop(tasks, 1)
+	if len(top) == 0 {
+		return nil
+	}
+
+	return &top[0]
+}
+
+func pickTop(tasks []model.Task, n int) []model.Task {
+	open := filterTasks(tasks, func(task model.Task) bool {
+		return !task.Archived && task.Status != model.StatusDone
+	})
+	slices.SortStableFunc(open, compareUrgency)
+
+	return open[:min(n, len(open))]
+}
+
+func compareUrgency(a, b model.Task) int {
+	if c := cmp.Compare(model.PriorityRank(a.Priority), model.PriorityRank(b.Priority)); c != 0 {
+		return c
+	}
+
+	aDue, aOk := dueTime(a)
+	bDue, bOk := dueTime(b)
+	switch {
+	case aOk && !bOk:
+		return -1
+	case !aOk && bOk:
+		return 1
+	case aOk && bOk:
+		if c := aDue.Compare(bDue); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(a.Id, b.Id)
+}
+
+func dueTime(task model.Task) (time.Time, bool) {
+	if task.DueDate == "" {
+		return time.Time{}, false
+	}
+
+	deadline, err := timeutil.DueDeadline(task.DueDate)
+	return deadline, err == nil
+}
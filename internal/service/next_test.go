@@ -0,0 +1,47 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"testing"
+)
+
+func TestPickNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []model.Task
+		want  int
+	}{
+		{"empty", nil, 0},
+		{"all done", []model.Task{{Id: 1, Status: model.StatusDone, Priority: model.PriorityHigh}}, 0},
+		{"skips archived", []model.Task{
+			{Id: 1, Status: model.StatusTodo, Priority: model.PriorityHigh, Archived: true},
+			{Id: 2, Status: model.StatusTodo, Priority: model.PriorityLow},
+		}, 2},
+		{"priority first", []model.Task{
+			{Id: 1, Status: model.StatusTodo, Priority: model.PriorityLow, DueDate: "2026-01-01"},
+			{Id: 2, Status: model.StatusInProgress, Priority: model.PriorityHigh},
+			{Id: 3, Status: model.StatusDone, Priority: model.PriorityHigh},
+		}, 2},
+		{"earliest due", []model.Task{
+			{Id: 1, Status: model.StatusTodo, Priority: model.PriorityMedium},
+			{Id: 2, Status: model.StatusTodo, Priority: model.PriorityMedium, DueDate: "2026-03-01"},
+			{Id: 3, Status: model.StatusTodo, Priority: model.PriorityMedium, DueDate: "2026-02-01"},
+		}, 3},
+		{"lowest id", []model.Task{
+			{Id: 5, Status: model.StatusTodo, Priority: model.PriorityMedium},
+			{Id: 4, Status: model.StatusTodo, Priority: model.PriorityMedium},
+		}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 0
+			if task := pickNext(tt.tasks); task != nil {
+				got = task.Id
+			}
+			if got != tt.want {
+				t.Errorf("pickNext = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
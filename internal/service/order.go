@@ -0,0 +1,122 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"slices"
+	"time"
+)
+
+func (s *taskService) MoveTask(id int, position int) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	sortByOrder(tasks)
+
+	active := len(tasks) - countDeleted(tasks)
+	if position < 1 || position > active {
+		return fmt.Errorf(i18n.T("неверная позиция %d (допустимо от 1 до %d)"), position, active)
+	}
+
+	index, err := taskIndexById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	task := tasks[index]
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	tasks = slices.Delete(tasks, index, index+1)
+	tasks = slices.Insert(tasks, position-1, task)
+
+	for i := range tasks {
+		tasks[i].Order = i + 1
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) SwapTasks(first int, second int) error {
+	if first == second {
+		return fmt.Errorf(i18n.T("нельзя поменять задачу с ID %d местами с самой собой"), first)
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	sortByOrder(tasks)
+	for i := range tasks {
+		tasks[i].Order = i + 1
+	}
+
+	a, err := taskById(tasks, first)
+	if err != nil {
+		return err
+	}
+	b, err := taskById(tasks, second)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	a.Order, b.Order = b.Order, a.Order
+	a.UpdatedAt = now
+	b.UpdatedAt = now
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func sortByOrder(tasks []model.Task) {
+	slices.SortStableFunc(tasks, func(a, b model.Task) int {
+		if a.Deleted != b.Deleted {
+			if a.Deleted {
+				return 1
+			}
+			return -1
+		}
+		return compareOrder(a, b)
+	})
+}
+
+func compareOrder(a, b model.Task) int {
+	if c := cmp.Compare(a.Order, b.Order); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Id, b.Id)
+}
+
+func countDeleted(tasks []model.Task) int {
+	count := 0
+	for _, task := range tasks {
+		if task.Deleted {
+			count++
+		}
+	}
+
+	return count
+}
+
+func nextOrder(tasks []model.Task) int {
+	maxOrder := 0
+	for _, task := range tasks {
+		maxOrder = max(maxOrder, task.Order)
+	}
+
+	return maxOrder + 1
+}
@@ -0,0 +1,30 @@
+package service
+
+import "go-task-cli/internal/model"
+
+func (s *taskService) SubtaskProgress() (map[int]model.Progress, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return subtaskProgress(tasks), nil
+}
+
+func subtaskProgress(tasks []model.Task) map[int]model.Progress {
+	progress := make(map[int]model.Progress)
+	for _, task := range tasks {
+		if task.ParentId == 0 || task.ParentId == task.Id {
+			continue
+		}
+
+		p := progress[task.ParentId]
+		p.Total++
+		if task.Status == model.StatusDone {
+			p.Done++
+		}
+		progress[task.ParentId] = p
+	}
+
+	return progress
+}
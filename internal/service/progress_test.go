@@ -0,0 +1,31 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"maps"
+	"testing"
+)
+
+func TestSubtaskProgress(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 1, Status: model.StatusTodo},
+		{Id: 2, Status: model.StatusDone, ParentId: 1},
+		{Id: 3, Status: model.StatusDone, ParentId: 1},
+		{Id: 4, Status: model.StatusInProgress, ParentId: 1},
+		{Id: 5, Status: model.StatusTodo, ParentId: 4},
+		{Id: 6, Status: model.StatusTodo},
+		{Id: 7, Status: model.StatusDone, ParentId: 7},
+	}
+
+	want := map[int]model.Progress{
+		1: {Done: 2, Total: 3},
+		4: {Done: 0, Total: 1},
+	}
+	got := subtaskProgress(tasks)
+	if !maps.Equal(got, want) {
+		t.Errorf("subtaskProgress = %v, want %v", got, want)
+	}
+	if _, ok := got[6]; ok {
+		t.Error("task without children has progress")
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"regexp"
+	"strings"
+)
+
+func (s *taskService) SearchTasks(query string, opts model.SearchOptions) ([]model.Task, error) {
+	matches, err := searchPredicate(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterTasks(tasks, matches), nil
+}
+
+func searchPredicate(query string, opts model.SearchOptions) (func(model.Task) bool, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New(i18n.T("поисковый запрос не может быть пустым"))
+	}
+	if opts.Exact && opts.Regexp {
+		return nil, errors.New(i18n.T("режимы --exact и --regexp несовместимы"))
+	}
+
+	switch {
+	case opts.Exact:
+		return func(task model.Task) bool {
+			return strings.TrimSpace(task.Description) == query
+		}, nil
+	case opts.Regexp:
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("неверное регулярное выражение %q: %v"), query, err)
+		}
+		return func(task model.Task) bool {
+			return re.MatchString(task.Description)
+		}, nil
+	default:
+		query = strings.ToLower(query)
+		return func(task model.Task) bool {
+			return strings.Contains(strings.ToLower(task.Description), query)
+		}, nil
+	}
+}
+
+func (s *taskService) FindOpenTask(desc string) (*model.Task, error) {
+	desc, err := normalizeDescription(desc)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, task := range tasks {
+		existing, _ := normalizeDescription(task.Description)
+		if task.Status != model.StatusDone && strings.EqualFold(existing, desc) {
+			return &tasks[i], nil
+		}
+	}
+
+	return nil, nil
+}
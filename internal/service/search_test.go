@@ -0,0 +1,93 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/repository"
+	"slices"
+	"testing"
+)
+
+func TestSearchPredicate(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 1, Description: "Buy milk"},
+		{Id: 2, Description: "buy bread"},
+		{Id: 3, Description: "Call mom about MILK"},
+		{Id: 4, Description: "Write report"},
+	}
+
+	tests := []struct {
+		query   string
+		opts    model.SearchOptions
+		want    []int
+		wantErr bool
+	}{
+		{"milk", model.SearchOptions{}, []int{1, 3}, false},
+		{"BUY", model.SearchOptions{}, []int{1, 2}, false},
+		{"  report ", model.SearchOptions{}, []int{4}, false},
+		{"nothing", model.SearchOptions{}, nil, false},
+		{"Buy milk", model.SearchOptions{Exact: true}, []int{1}, false},
+		{"buy milk", model.SearchOptions{Exact: true}, nil, false},
+		{"^[Bb]uy", model.SearchOptions{Regexp: true}, []int{1, 2}, false},
+		{"", model.SearchOptions{}, nil, true},
+		{"   ", model.SearchOptions{}, nil, true},
+		{"(", model.SearchOptions{Regexp: true}, nil, true},
+		{"x", model.SearchOptions{Exact: true, Regexp: true}, nil, true},
+	}
+
+	for _, tt := range tests {
+		matches, err := searchPredicate(tt.query, tt.opts)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("searchPredicate(%q, %+v) error = %v, wantErr %v", tt.query, tt.opts, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		var ids []int
+		for _, task := range filterTasks(tasks, matches) {
+			ids = append(ids, task.Id)
+		}
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("search %q %+v = %v, want %v", tt.query, tt.opts, ids, tt.want)
+		}
+	}
+}
+
+func TestFindOpenTask(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 1, Description: "Buy milk", Status: model.StatusTodo},
+		{Id: 2, Description: "Call mom", Status: model.StatusDone},
+		{Id: 3, Description: "Write report", Status: model.StatusInProgress},
+		{Id: 4, Description: "Old chore", Status: model.StatusTodo, Deleted: true},
+	}
+
+	tests := []struct {
+		desc string
+		want int
+	}{
+		{"Buy milk", 1},
+		{"buy MILK", 1},
+		{"  buy   milk ", 1},
+		{"write report", 3},
+		{"Call mom", 0},
+		{"Old chore", 0},
+		{"Buy bread", 0},
+	}
+
+	serv := NewTaskService(repository.NewMemoryTaskRepository(tasks))
+	for _, tt := range tests {
+		task, err := serv.FindOpenTask(tt.desc)
+		if err != nil {
+			t.Fatalf("FindOpenTask(%q): %v", tt.desc, err)
+		}
+
+		got := 0
+		if task != nil {
+			got = task.Id
+		}
+		if got != tt.want {
+			t.Errorf("FindOpenTask(%q) = %d, want %d", tt.desc, got, tt.want)
+		}
+	}
+}
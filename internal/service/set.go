@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"slices"
+	"strings"
+	"time"
+)
+
+var settableFields = []string{"description", "status", "priority", "due", "tags"}
+
+func (s *taskService) SetFields(id int, values map[string]string, force bool) error {
+	for field := range values {
+		if !slices.Contains(settableFields, field) {
+			return fmt.Errorf(i18n.T("неизвестное поле %q (допустимо: %s)"), field, strings.Join(settableFields, ", "))
+		}
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if value, ok := values["description"]; ok {
+		if task.Description, err = normalizeDescription(value); err != nil {
+			return err
+		}
+	}
+	if value, ok := values["priority"]; ok {
+		priority := model.TaskPriority(value)
+		if !slices.Contains(model.Priorities, priority) {
+			return fmt.Errorf(i18n.T("неверный приоритет %q (допустимо: low, medium, high)"), value)
+		}
+		task.Priority = priority
+	}
+	if value, ok := values["due"]; ok {
+		task.DueDate = ""
+		if value != "" {
+			if task.DueDate, err = timeutil.ResolveDate(value, now); err != nil {
+				return err
+			}
+		}
+	}
+	if value, ok := values["tags"]; ok {
+		task.Tags = nil
+		for part := range strings.SplitSeq(value, ",") {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			tag, err := normalizeTag(part)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(task.Tags, tag) {
+				task.Tags = append(task.Tags, tag)
+			}
+		}
+	}
+	task.UpdatedAt = now.UTC().Format(time.RFC3339)
+
+	if value, ok := values["status"]; ok {
+		status := model.TaskStatus(value)
+		if !model.IsValidStatus(status) {
+			return invalidStatusError(status)
+		}
+		if task.Status != status {
+			if _, err := markTasks(tasks, []int{id}, status, force); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"slices"
+	"time"
+)
+
+func sortTasks(tasks []model.Task, key string, reverse bool) error {
+	var compare func(a, b model.Task) int
+	switch key {
+	case "", "order":
+		compare = compareOrder
+	case "id":
+		compare = func(a, b model.Task) int {
+			return cmp.Compare(a.Id, b.Id)
+		}
+	case "created":
+		compare = func(a, b model.Task) int {
+			return parseTimestamp(a.CreatedAt).Compare(parseTimestamp(b.CreatedAt))
+		}
+	case "updated":
+		compare = func(a, b model.Task) int {
+			return parseTimestamp(a.UpdatedAt).Compare(parseTimestamp(b.UpdatedAt))
+		}
+	case "status":
+		compare = func(a, b model.Task) int {
+			return cmp.Compare(statusRank(a.Status), statusRank(b.Status))
+		}
+	case "priority":
+		compare = func(a, b model.Task) int {
+			return cmp.Compare(model.PriorityRank(a.Priority), model.PriorityRank(b.Priority))
+		}
+	default:
+		return fmt.Errorf(i18n.T("неверный ключ сортировки %q (допустимо: order, id, created, updated, status, priority)"), key)
+	}
+
+	slices.SortStableFunc(tasks, compare)
+	if reverse {
+		slices.Reverse(tasks)
+	}
+	slices.SortStableFunc(tasks, func(a, b model.Task) int {
+		switch {
+		case a.Pinned == b.Pinned:
+			return 0
+		case a.Pinned:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return nil
+}
+
+func statusRank(status model.TaskStatus) int {
+	if rank := slices.Index(model.Statuses, status); rank != -1 {
+		return rank
+	}
+
+	return len(model.Statuses)
+}
+
+func parseTimestamp(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
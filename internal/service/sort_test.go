@@ -0,0 +1,91 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"slices"
+	"testing"
+)
+
+func sortedIds(t *testing.T, tasks []model.Task, key string, reverse bool) []int {
+	t.Helper()
+	sorted := slices.Clone(tasks)
+	if err := sortTasks(sorted, key, reverse); err != nil {
+		t.Fatalf("sortTasks(%q): %v", key, err)
+	}
+
+	ids := make([]int, len(sorted))
+	for i, task := range sorted {
+		ids[i] = task.Id
+	}
+	return ids
+}
+
+func TestSortTasks(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 3, Order: 1, Status: model.StatusDone, CreatedAt: "2026-01-03T00:00:00Z", UpdatedAt: "2026-02-01T00:00:00Z"},
+		{Id: 1, Order: 3, Status: model.StatusTodo, CreatedAt: "2026-01-01T00:00:00Z", UpdatedAt: "bad"},
+		{Id: 4, Order: 2, Status: model.StatusInProgress, CreatedAt: "", UpdatedAt: "2026-03-01T00:00:00Z"},
+		{Id: 2, Order: 4, Status: model.StatusTodo, CreatedAt: "2026-01-02T00:00:00Z", UpdatedAt: "2026-01-01T00:00:00Z"},
+	}
+
+	tests := []struct {
+		key     string
+		reverse bool
+		want    []int
+	}{
+		{"", false, []int{3, 4, 1, 2}},
+		{"order", false, []int{3, 4, 1, 2}},
+		{"id", false, []int{1, 2, 3, 4}},
+		{"id", true, []int{4, 3, 2, 1}},
+		{"created", false, []int{4, 1, 2, 3}},
+		{"updated", false, []int{1, 2, 3, 4}},
+		{"status", false, []int{1, 2, 4, 3}},
+		{"status", true, []int{3, 4, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		if got := sortedIds(t, tasks, tt.key, tt.reverse); !slices.Equal(got, tt.want) {
+			t.Errorf("sortTasks(%q, reverse=%v) = %v, want %v", tt.key, tt.reverse, got, tt.want)
+		}
+	}
+
+	if err := sortTasks(slices.Clone(tasks), "size", false); err == nil {
+		t.Error("sortTasks(size): expected error")
+	}
+}
+
+func TestSortTasksByPriority(t *testing.T) {
+	tasks := []model.Task{
+		{Id: 1, Priority: model.PriorityLow},
+		{Id: 2, Priority: "legacy"},
+		{Id: 3, Priority: model.PriorityHigh},
+		{Id: 4, Priority: model.PriorityMedium},
+		{Id: 5, Priority: model.PriorityHigh},
+	}
+
+	tests := []struct {
+		name    string
+		reverse bool
+		want    []int
+	}{
+		{"ascending", false, []int{3, 5, 4, 1, 2}},
+		{"reverse", true, []int{2, 1, 4, 5, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := slices.Clone(tasks)
+			if err := sortTasks(sorted, "priority", tt.reverse); err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int
+			for _, task := range sorted {
+				ids = append(ids, task.Id)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("sortTasks(priority, reverse=%v) = %v, want %v", tt.reverse, ids, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"time"
+)
+
+func (s *taskService) Stats() (model.TaskStats, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return model.TaskStats{}, err
+	}
+
+	return computeStats(tasks), nil
+}
+
+func computeStats(tasks []model.Task) model.TaskStats {
+	stats := model.TaskStats{
+		Total:    len(tasks),
+		ByStatus: countByStatus(tasks),
+	}
+
+	if stats.Total != 0 {
+		stats.DonePercent = float64(stats.ByStatus[model.StatusDone]) * 100 / float64(stats.Total)
+	}
+
+	var total time.Duration
+	for _, task := range tasks {
+		if task.Status != model.StatusDone {
+			continue
+		}
+
+		created, err := time.Parse(time.RFC3339, task.CreatedAt)
+		if err != nil {
+			continue
+		}
+		completed, err := time.Parse(time.RFC3339, task.CompletedAt)
+		if err != nil || completed.Before(created) {
+			continue
+		}
+
+		total += completed.Sub(created)
+		stats.CompletedTimed++
+	}
+
+	if stats.CompletedTimed != 0 {
+		stats.AverageCompletion = total / time.Duration(stats.CompletedTimed)
+	}
+
+	return stats
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"testing"
+	"time"
+)
+
+func TestComputeStats(t *testing.T) {
+	tasks := []model.Task{
+		{Status: model.StatusDone, CreatedAt: "2026-01-01T00:00:00Z", CompletedAt: "2026-01-01T02:00:00Z"},
+		{Status: model.StatusDone, CreatedAt: "2026-01-01T00:00:00Z", CompletedAt: "2026-01-02T00:00:00Z"},
+		{Status: model.StatusDone, CreatedAt: "bad", CompletedAt: "2026-01-02T00:00:00Z"},
+		{Status: model.StatusDone, CreatedAt: "2026-01-03T00:00:00Z", CompletedAt: "2026-01-02T00:00:00Z"},
+		{Status: model.StatusTodo, CreatedAt: "2026-01-01T00:00:00Z"},
+		{Status: model.StatusInProgress, CreatedAt: "2026-01-01T00:00:00Z"},
+		{Status: model.StatusTodo, CreatedAt: "2026-01-01T00:00:00Z"},
+		{Status: model.StatusTodo, CreatedAt: "2026-01-01T00:00:00Z"},
+	}
+
+	stats := computeStats(tasks)
+	if stats.Total != 8 {
+		t.Errorf("Total = %d, want 8", stats.Total)
+	}
+	if stats.DonePercent != 50 {
+		t.Errorf("DonePercent = %v, want 50", stats.DonePercent)
+	}
+	if got := stats.ByStatus[model.StatusTodo]; got != 3 {
+		t.Errorf("ByStatus[todo] = %d, want 3", got)
+	}
+	if stats.CompletedTimed != 2 {
+		t.Errorf("CompletedTimed = %d, want 2", stats.CompletedTimed)
+	}
+	if want := 13 * time.Hour; stats.AverageCompletion != want {
+		t.Errorf("AverageCompletion = %v, want %v", stats.AverageCompletion, want)
+	}
+}
+
+func TestComputeStatsEmpty(t *testing.T) {
+	stats := computeStats(nil)
+	if stats.Total != 0 || stats.DonePercent != 0 || stats.AverageCompletion != 0 {
+		t.Errorf("computeStats(nil) = %+v, want zero values", stats)
+	}
+}
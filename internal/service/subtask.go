@@ -0,0 +1,46 @@
+package service
+
+import (
+	"go-task-cli/internal/model"
+	"slices"
+)
+
+func descendantIds(tasks []model.Task, ids []int) []int {
+	var result []int
+	queue := slices.Clone(ids)
+	for len(queue) > 0 {
+		parentId := queue[0]
+		queue = queue[1:]
+
+		for _, task := range tasks {
+			if task.Deleted || task.ParentId != parentId {
+				continue
+			}
+			if slices.Contains(ids, task.Id) || slices.Contains(result, task.Id) {
+				continue
+			}
+
+			result = append(result, task.Id)
+			queue = append(queue, task.Id)
+		}
+	}
+
+	return result
+}
+
+func hasAncestor(tasks []model.Task, id int, ancestorId int) bool {
+	visited := make(map[int]bool)
+	for id != 0 && !visited[id] {
+		visited[id] = true
+		task, err := taskById(tasks, id)
+		if err != nil {
+			return false
+		}
+		if task.ParentId == ancestorId {
+			return true
+		}
+		id = task.ParentId
+	}
+
+	return false
+}
@@ -1,14 +1,21 @@
 package service
 
 import (
+	"errors"
 	"fmt"
+	"go-task-cli/internal/i18n"
 	"go-task-cli/internal/model"
+	"go-task-cli/internal/timeutil"
+	"slices"
+	"strings"
 	"time"
 )
 
 type taskRepository interface {
 	LoadTasks() ([]model.Task, error)
 	SaveTasks(tasks []model.Task) error
+	RestoreBackup() error
+	LastId() (int, error)
 }
 
 type taskService struct {
@@ -19,147 +26,847 @@ func NewTaskService(repo taskRepository) *taskService {
 	return &taskService{repo: repo}
 }
 
-func (s *taskService) AddTask(desc string) (*model.Task, error) {
+func (s *taskService) AddTask(desc string, parentId int) (*model.Task, error) {
+	added, err := s.AddTasks([]string{desc}, parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &added[0], nil
+}
+
+func (s *taskService) AddTasks(descs []string, parentId int) ([]model.Task, error) {
+	for i, desc := range descs {
+		desc, err := normalizeDescription(desc)
+		if err != nil {
+			return nil, err
+		}
+		descs[i] = desc
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	if parentId != 0 {
+		if _, err := taskById(tasks, parentId); err != nil {
+			return nil, fmt.Errorf(i18n.T("родительская задача с ID %d не найдена"), parentId)
+		}
+	}
+
+	lastId, err := s.repo.LastId()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки счетчика ID: %w"), err)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	added := make([]model.Task, 0, len(descs))
+	for _, desc := range descs {
+		newTask := model.Task{
+			Id:          nextId(tasks, lastId),
+			Description: desc,
+			Status:      model.StatusTodo,
+			Priority:    model.PriorityMedium,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Order:       nextOrder(tasks),
+			ParentId:    parentId,
+		}
+		tasks = append(tasks, newTask)
+		added = append(added, newTask)
+	}
+
+	if err := s.repo.SaveTasks(tasks); err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return added, nil
+}
+
+func (s *taskService) CloneTask(id int) (*model.Task, error) {
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return nil, fmt.Errorf("ошибка загрузки задач: %w", err)
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	source, err := taskById(tasks, id)
+	if err != nil {
+		return nil, err
 	}
 
-	now := time.Now().Format(time.RFC3339)
+	lastId, err := s.repo.LastId()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки счетчика ID: %w"), err)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
 	newTask := model.Task{
-		Id:          s.nextId(),
-		Description: desc,
+		Id:          nextId(tasks, lastId),
+		Description: source.Description,
 		Status:      model.StatusTodo,
+		Priority:    source.Priority,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Tags:        slices.Clone(source.Tags),
+		Order:       nextOrder(tasks),
+		ParentId:    source.ParentId,
 	}
 
 	tasks = append(tasks, newTask)
 
 	if err := s.repo.SaveTasks(tasks); err != nil {
-		return nil, fmt.Errorf("ошибка записи файла задач: %w", err)
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
 	}
 
 	return &newTask, nil
 }
 
+func (s *taskService) ImportTasks(imported []model.Task) (int, error) {
+	for i := range imported {
+		desc, err := normalizeDescription(imported[i].Description)
+		if err != nil {
+			return 0, err
+		}
+		imported[i].Description = desc
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	lastId, err := s.repo.LastId()
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки счетчика ID: %w"), err)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, task := range imported {
+		task.Id = nextId(tasks, lastId)
+		task.Order = nextOrder(tasks)
+		if task.Priority == "" {
+			task.Priority = model.PriorityMedium
+		}
+		if task.CreatedAt == "" {
+			task.CreatedAt = now
+		}
+		if task.UpdatedAt == "" {
+			task.UpdatedAt = now
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := s.repo.SaveTasks(tasks); err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return len(imported), nil
+}
+
 func (s *taskService) UpdateTask(id int, desc string) error {
+	desc, err := normalizeDescription(desc)
+	if err != nil {
+		return err
+	}
+
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return fmt.Errorf("ошибка загрузки задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
 	}
 
-	i, err := s.taskIndexById(id)
+	task, err := taskById(tasks, id)
 	if err != nil {
-		return fmt.Errorf("задача с ID %d не найдена", id)
+		return err
 	}
 
-	now := time.Now().Format(time.RFC3339)
-	task := tasks[i]
 	task.Description = desc
-	task.UpdatedAt = now
-	tasks[i] = task
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
 
 	err = s.repo.SaveTasks(tasks)
 	if err != nil {
-		return fmt.Errorf("ошибка записи файла задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
 	}
 
 	return nil
 }
 
-func (s *taskService) DeleteTask(id int) error {
+func (s *taskService) AppendDescription(id int, text string) error {
+	text, err := normalizeDescription(text)
+	if err != nil {
+		return err
+	}
+
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return fmt.Errorf("ошибка загрузки задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
 	}
 
-	i, err := s.taskIndexById(id)
+	task, err := taskById(tasks, id)
 	if err != nil {
-		return fmt.Errorf("задача с ID %d не найдена", id)
+		return err
 	}
 
-	tasks = append(tasks[:i], tasks[i+1:]...)
+	if task.Description == "" {
+		task.Description = text
+	} else {
+		task.Description += "\n" + text
+	}
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
 
 	err = s.repo.SaveTasks(tasks)
 	if err != nil {
-		return fmt.Errorf("ошибка записи файла задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
 	}
 
 	return nil
 }
 
-func (s *taskService) MarkTask(id int, status model.TaskStatus) error {
+func (s *taskService) SetNotes(id int, notes string) error {
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return fmt.Errorf("ошибка загрузки задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
 	}
 
-	i, err := s.taskIndexById(id)
+	task, err := taskById(tasks, id)
 	if err != nil {
-		return fmt.Errorf("задача с ID %d не найдена", id)
+		return err
 	}
 
-	now := time.Now().Format(time.RFC3339)
-	task := tasks[i]
-	task.Status = status
-	task.UpdatedAt = now
-	tasks[i] = task
+	task.Notes = strings.TrimSpace(notes)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
 
 	err = s.repo.SaveTasks(tasks)
 	if err != nil {
-		return fmt.Errorf("ошибка записи файла задач: %w", err)
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
 	}
 
 	return nil
 }
 
-func (s *taskService) ListTasks(statusFilter model.TaskStatus) ([]model.Task, error) {
+func (s *taskService) DeleteTasks(ids []int, opts model.DeleteOptions) ([]int, error) {
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return nil, fmt.Errorf("ошибка загрузки задач: %w", err)
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
 	}
 
-	var filteredTasks []model.Task
-	if statusFilter == "" {
-		filteredTasks = tasks
+	if opts.Cascade {
+		ids = append(ids, descendantIds(tasks, ids)...)
 	} else {
-		for _, task := range tasks {
-			if task.Status == statusFilter {
-				filteredTasks = append(filteredTasks, task)
+		for _, id := range ids {
+			for _, child := range descendantIds(tasks, []int{id}) {
+				if !slices.Contains(ids, child) {
+					return nil, fmt.Errorf(i18n.T("у задачи с ID %d есть подзадачи, используйте --cascade"), id)
+				}
 			}
 		}
 	}
 
-	return filteredTasks, nil
+	if err := ensureUniqueIds(tasks, ids); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	var notFound []int
+	for _, id := range ids {
+		if opts.Hard {
+			i := slices.IndexFunc(tasks, func(task model.Task) bool { return task.Id == id })
+			if i == -1 {
+				notFound = append(notFound, id)
+				continue
+			}
+
+			tasks = slices.Delete(tasks, i, i+1)
+			continue
+		}
+
+		task, err := taskById(tasks, id)
+		if err != nil {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		task.Deleted = true
+		task.DeletedAt = now
+		task.UpdatedAt = now
+	}
+
+	if len(notFound) == len(ids) {
+		return notFound, nil
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return notFound, nil
 }
 
-func (s *taskService) taskIndexById(id int) (int, error) {
+func (s *taskService) ClearTasks() (int, error) {
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
 	}
 
-	for i, task := range tasks {
-		if task.Id == id {
-			return i, nil
+	err = s.repo.SaveTasks([]model.Task{})
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return len(tasks), nil
+}
+
+func (s *taskService) MarkTasks(ids []int, status model.TaskStatus, force bool) ([]int, error) {
+	if !model.IsValidStatus(status) {
+		return nil, invalidStatusError(status)
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	notFound, err := markTasks(tasks, ids, status, force)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(notFound) == len(ids) {
+		return notFound, nil
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return notFound, nil
+}
+
+func (s *taskService) MarkTasksInStatus(from model.TaskStatus, status model.TaskStatus, force bool) ([]int, error) {
+	if !model.IsValidStatus(status) {
+		return nil, invalidStatusError(status)
+	}
+	if from != "" && !model.IsValidStatus(from) {
+		return nil, invalidStatusError(from)
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	var ids []int
+	for _, task := range tasks {
+		if task.Deleted || task.Archived || task.Status == status {
+			continue
+		}
+		if from == "" || task.Status == from {
+			ids = append(ids, task.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if _, err := markTasks(tasks, ids, status, force); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return ids, nil
+}
+
+func markTasks(tasks []model.Task, ids []int, status model.TaskStatus, force bool) ([]int, error) {
+	if err := ensureUniqueIds(tasks, ids); err != nil {
+		return nil, err
+	}
+	if status == model.StatusDone && !force {
+		for _, id := range ids {
+			task, err := taskById(tasks, id)
+			if err != nil || task.Status == model.StatusDone {
+				continue
+			}
+			if pending := pendingDependencies(tasks, *task); len(pending) != 0 {
+				return nil, fmt.Errorf(i18n.T("задача с ID %d зависит от незавершенных задач (ID: %s), используйте --force"), id, model.JoinIds(pending))
+			}
+		}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	var notFound []int
+	for _, id := range ids {
+		task, err := taskById(tasks, id)
+		if err != nil {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		if status != model.StatusDone {
+			task.CompletedAt = ""
+		} else if task.Status != model.StatusDone {
+			task.CompletedAt = now
+			stopTimer(task, now)
+		}
+		task.Status = status
+		task.UpdatedAt = now
+	}
+
+	return notFound, nil
+}
+
+func (s *taskService) ReopenTask(id int) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	if task.Status == model.StatusTodo {
+		return fmt.Errorf(i18n.T("задача с ID %d уже в статусе todo"), id)
+	}
+
+	task.Status = model.StatusTodo
+	task.CompletedAt = ""
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) SetDueDate(id int, dueDate string) (string, error) {
+	dueDate, err := timeutil.ResolveDate(dueDate, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return "", err
+	}
+
+	task.DueDate = dueDate
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return dueDate, nil
+}
+
+func (s *taskService) SnoozeTask(id int, by time.Duration) (string, error) {
+	if by <= 0 {
+		return "", errors.New(i18n.T("срок откладывания должен быть положительным"))
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return "", err
+	}
+
+	dueDate, err := timeutil.ShiftDue(task.DueDate, by, time.Now())
+	if err != nil {
+		return "", err
+	}
+	task.DueDate = dueDate
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return dueDate, nil
+}
+
+func (s *taskService) SetPriority(id int, priority model.TaskPriority) error {
+	switch priority {
+	case model.PriorityLow, model.PriorityMedium, model.PriorityHigh:
+	default:
+		return fmt.Errorf(i18n.T("неверный приоритет %q (допустимо: low, medium, high)"), priority)
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	task.Priority = priority
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) AddTag(id int, tag string) (bool, error) {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return false, err
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return false, err
+	}
+
+	if slices.Contains(task.Tags, tag) {
+		return false, nil
+	}
+
+	task.Tags = append(task.Tags, tag)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return true, nil
+}
+
+func (s *taskService) RemoveTag(id int, tag string) error {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	i := slices.Index(task.Tags, tag)
+	if i < 0 {
+		return fmt.Errorf(i18n.T("у задачи с ID %d нет тега %q"), id, tag)
+	}
+
+	task.Tags = slices.Delete(task.Tags, i, i+1)
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) SetArchived(id int, archived bool) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	if task.Archived == archived {
+		if archived {
+			return fmt.Errorf(i18n.T("задача с ID %d уже в архиве"), id)
 		}
+		return fmt.Errorf(i18n.T("задача с ID %d не в архиве"), id)
 	}
 
-	return 0, fmt.Errorf("Задача не найдена (ID: %d)", id)
+	task.Archived = archived
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
 }
 
-func (s *taskService) nextId() int {
+func (s *taskService) SetPinned(id int, pinned bool) error {
 	tasks, err := s.repo.LoadTasks()
 	if err != nil {
-		return 1
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	if task.Pinned == pinned {
+		if pinned {
+			return fmt.Errorf(i18n.T("задача с ID %d уже закреплена"), id)
+		}
+		return fmt.Errorf(i18n.T("задача с ID %d не закреплена"), id)
+	}
+
+	task.Pinned = pinned
+	task.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) Undo() error {
+	return s.repo.RestoreBackup()
+}
+
+func (s *taskService) ListTasks(filter model.TaskFilter) ([]model.Task, error) {
+	if filter.Status != "" && !model.IsValidStatus(filter.Status) {
+		return nil, invalidStatusError(filter.Status)
+	}
+	for _, status := range filter.Statuses {
+		if !model.IsValidStatus(status) {
+			return nil, invalidStatusError(status)
+		}
+	}
+	if filter.Limit < 0 || filter.Offset < 0 {
+		return nil, errors.New(i18n.T("значения limit и offset не могут быть отрицательными"))
+	}
+
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	tag := strings.ToLower(strings.TrimSpace(filter.Tag))
+	assignee := strings.TrimSpace(filter.Assignee)
+
+	filteredTasks := filterTasks(tasks, func(task model.Task) bool {
+		if !filter.IncludeArchived && task.Archived != filter.Archived {
+			return false
+		}
+		if filter.Status != "" && task.Status != filter.Status {
+			return false
+		}
+		if len(filter.Statuses) != 0 && !slices.Contains(filter.Statuses, task.Status) {
+			return false
+		}
+		if filter.Pinned && !task.Pinned {
+			return false
+		}
+		if !filter.Since.IsZero() && parseTimestamp(task.UpdatedAt).Before(filter.Since) {
+			return false
+		}
+		if tag != "" && !slices.Contains(task.Tags, tag) {
+			return false
+		}
+		if !matchesAssignee(task, assignee) {
+			return false
+		}
+		if filter.Blocked && (task.Status == model.StatusDone || len(pendingDependencies(tasks, task)) == 0) {
+			return false
+		}
+		return true
+	})
+
+	if err := sortTasks(filteredTasks, filter.Sort, filter.Reverse); err != nil {
+		return nil, err
+	}
+
+	return paginate(filteredTasks, filter.Limit, filter.Offset), nil
+}
+
+func (s *taskService) OverdueTasks(now time.Time) ([]model.Task, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
 	}
 
-	if len(tasks) == 0 {
-		return 1
+	return filterTasks(tasks, func(task model.Task) bool {
+		if task.Archived || task.Status == model.StatusDone || task.DueDate == "" {
+			return false
+		}
+
+		deadline, err := timeutil.DueDeadline(task.DueDate)
+		return err == nil && deadline.Before(now)
+	}), nil
+}
+
+func (s *taskService) DueTodayTasks(now time.Time) ([]model.Task, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterTasks(tasks, func(task model.Task) bool {
+		if task.Archived || task.DueDate == "" {
+			return false
+		}
+
+		due, err := timeutil.ParseDate(task.DueDate)
+		return err == nil && timeutil.SameDay(due, now)
+	}), nil
+}
+
+func (s *taskService) CountTasks() (map[model.TaskStatus]int, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return nil, err
 	}
 
-	id := 0
+	return countByStatus(tasks), nil
+}
+
+func countByStatus(tasks []model.Task) map[model.TaskStatus]int {
+	counts := make(map[model.TaskStatus]int, len(model.Statuses))
+	for _, status := range model.Statuses {
+		counts[status] = 0
+	}
+	for _, task := range tasks {
+		counts[task.Status]++
+	}
+
+	return counts
+}
+
+func filterTasks(tasks []model.Task, predicate func(model.Task) bool) []model.Task {
+	var filteredTasks []model.Task
+	for _, task := range tasks {
+		if predicate(task) {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+
+	return filteredTasks
+}
+
+func paginate(tasks []model.Task, limit, offset int) []model.Task {
+	if offset >= len(tasks) {
+		return []model.Task{}
+	}
+
+	tasks = tasks[offset:]
+	if limit > 0 && limit < len(tasks) {
+		tasks = tasks[:limit]
+	}
+
+	return tasks
+}
+
+func normalizeDescription(desc string) (string, error) {
+	lines := strings.Split(desc, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	desc = strings.TrimSpace(strings.Join(lines, "\n"))
+	if desc == "" {
+		return "", errors.New(i18n.T("описание задачи не может быть пустым"))
+	}
+
+	return desc, nil
+}
+
+func invalidStatusError(status model.TaskStatus) error {
+	valid := make([]string, len(model.Statuses))
+	for i, s := range model.Statuses {
+		valid[i] = string(s)
+	}
+
+	return fmt.Errorf(i18n.T("неверный статус %q (допустимо: %s)"), status, strings.Join(valid, ", "))
+}
+
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return "", errors.New(i18n.T("тег не может быть пустым"))
+	}
+
+	return tag, nil
+}
+
+func taskIndexById(tasks []model.Task, id int) (int, error) {
+	index := -1
+	for i, task := range tasks {
+		if task.Id != id || task.Deleted {
+			continue
+		}
+		if index != -1 {
+			return 0, duplicateIdError(id)
+		}
+		index = i
+	}
+	if index == -1 {
+		return 0, fmt.Errorf(i18n.T("задача с ID %d не найдена"), id)
+	}
+
+	return index, nil
+}
+
+type duplicateIdError int
+
+func (e duplicateIdError) Error() string {
+	return fmt.Sprintf(i18n.T("в файле задач несколько задач с ID %d, исправьте их командой doctor --fix"), int(e))
+}
+
+func ensureUniqueIds(tasks []model.Task, ids []int) error {
+	for _, id := range ids {
+		var duplicate duplicateIdError
+		if _, err := taskIndexById(tasks, id); errors.As(err, &duplicate) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func taskById(tasks []model.Task, id int) (*model.Task, error) {
+	i, err := taskIndexById(tasks, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tasks[i], nil
+}
+
+func nextId(tasks []model.Task, lastId int) int {
+	id := lastId + 1
 	for _, task := range tasks {
 		if task.Id >= id {
 			id = task.Id + 1
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"go-task-cli/internal/repository"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func sampleTasks(n int) []model.Task {
+	tasks := make([]model.Task, n)
+	for i := range tasks {
+		tasks[i] = model.Task{Id: i + 1, Description: "task", Status: model.StatusTodo}
+	}
+	return tasks
+}
+
+func mustTask(t *testing.T, tasks []model.Task, id int) model.Task {
+	t.Helper()
+	for _, task := range tasks {
+		if task.Id == id {
+			return task
+		}
+	}
+	t.Fatalf("task %d not found", id)
+	return model.Task{}
+}
+
+func TestUpdateAfterDelete(t *testing.T) {
+	for _, hard := range []bool{false, true} {
+		repo := repository.NewMemoryTaskRepository(sampleTasks(5))
+		serv := NewTaskService(repo)
+
+		if _, err := serv.DeleteTasks([]int{1}, model.DeleteOptions{Hard: hard}); err != nil {
+			t.Fatalf("DeleteTasks(hard=%v): %v", hard, err)
+		}
+		if err := serv.UpdateTask(4, "updated"); err != nil {
+			t.Fatalf("UpdateTask(hard=%v): %v", hard, err)
+		}
+		if _, err := serv.MarkTasks([]int{5}, model.StatusDone, false); err != nil {
+			t.Fatalf("MarkTasks(hard=%v): %v", hard, err)
+		}
+
+		tasks, _ := repo.LoadTasks()
+		for _, task := range tasks {
+			wantDesc, wantStatus := "task", model.StatusTodo
+			switch task.Id {
+			case 4:
+				wantDesc = "updated"
+			case 5:
+				wantStatus = model.StatusDone
+			}
+			if task.Description != wantDesc || task.Status != wantStatus {
+				t.Errorf("hard=%v: task %d = %q/%s, want %q/%s", hard, task.Id, task.Description, task.Status, wantDesc, wantStatus)
+			}
+		}
+	}
+}
+
+func TestDeleteTasks(t *testing.T) {
+	tests := []struct {
+		name         string
+		ids          []int
+		wantIds      []int
+		wantNotFound []int
+	}{
+		{"middle", []int{3}, []int{1, 2, 4, 5}, nil},
+		{"end", []int{5}, []int{1, 2, 3, 4}, nil},
+		{"first", []int{1}, []int{2, 3, 4, 5}, nil},
+		{"non-existent", []int{9}, []int{1, 2, 3, 4, 5}, []int{9}},
+		{"mixed", []int{2, 9}, []int{1, 3, 4, 5}, []int{9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repository.NewMemoryTaskRepository(sampleTasks(5))
+			notFound, err := NewTaskService(repo).DeleteTasks(tt.ids, model.DeleteOptions{Hard: true})
+			if err != nil {
+				t.Fatalf("DeleteTasks(%v): %v", tt.ids, err)
+			}
+			if !slices.Equal(notFound, tt.wantNotFound) {
+				t.Errorf("DeleteTasks(%v) notFound = %v, want %v", tt.ids, notFound, tt.wantNotFound)
+			}
+
+			tasks, _ := repo.LoadTasks()
+			var ids []int
+			for _, task := range tasks {
+				ids = append(ids, task.Id)
+			}
+			if !slices.Equal(ids, tt.wantIds) {
+				t.Errorf("DeleteTasks(%v) left %v, want %v", tt.ids, ids, tt.wantIds)
+			}
+		})
+	}
+}
+
+func TestTaskByIdNotFound(t *testing.T) {
+	want := fmt.Sprintf(i18n.T("задача с ID %d не найдена"), 7)
+	if _, err := taskById(sampleTasks(2), 7); err == nil || err.Error() != want {
+		t.Errorf("taskById(7) error = %v, want %q", err, want)
+	}
+}
+
+func TestCountByStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []model.Task
+		want  map[model.TaskStatus]int
+	}{
+		{"empty", nil, map[model.TaskStatus]int{model.StatusTodo: 0, model.StatusInProgress: 0, model.StatusDone: 0}},
+		{"mixed", []model.Task{
+			{Status: model.StatusTodo},
+			{Status: model.StatusDone},
+			{Status: model.StatusTodo},
+			{Status: model.StatusInProgress},
+		}, map[model.TaskStatus]int{model.StatusTodo: 2, model.StatusInProgress: 1, model.StatusDone: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countByStatus(tt.tasks); !maps.Equal(got, tt.want) {
+				t.Errorf("countByStatus = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndoDelete(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tasks.json")
+	serv := NewTaskService(repository.NewTaskRepository(file, false, func(error) {}))
+	for _, desc := range []string{"first", "second"} {
+		if _, err := serv.AddTask(desc, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := serv.DeleteTasks([]int{2}, model.DeleteOptions{Hard: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := serv.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+
+	tasks, err := serv.ListTasks(model.TaskFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 || tasks[1].Description != "second" {
+		t.Errorf("after undo tasks = %+v, want both tasks", tasks)
+	}
+	if err := serv.Undo(); err == nil {
+		t.Error("second Undo: expected error, only one level is kept")
+	}
+}
+
+func TestCompletedAt(t *testing.T) {
+	repo := repository.NewMemoryTaskRepository(sampleTasks(1))
+	serv := NewTaskService(repo)
+
+	steps := []struct {
+		status        model.TaskStatus
+		wantCompleted bool
+	}{
+		{model.StatusDone, true},
+		{model.StatusTodo, false},
+		{model.StatusDone, true},
+		{model.StatusInProgress, false},
+	}
+
+	for _, step := range steps {
+		if _, err := serv.MarkTasks([]int{1}, step.status, false); err != nil {
+			t.Fatalf("MarkTasks(%s): %v", step.status, err)
+		}
+
+		tasks, _ := repo.LoadTasks()
+		completedAt := mustTask(t, tasks, 1).CompletedAt
+		if got := completedAt != ""; got != step.wantCompleted {
+			t.Errorf("after mark %s CompletedAt = %q, want set=%v", step.status, completedAt, step.wantCompleted)
+		}
+	}
+}
+
+func TestAppendDescriptionTwice(t *testing.T) {
+	repo := repository.NewMemoryTaskRepository(sampleTasks(1))
+	serv := NewTaskService(repo)
+
+	for _, text := range []string{"second line", "  third   line "} {
+		if err := serv.AppendDescription(1, text); err != nil {
+			t.Fatalf("AppendDescription(%q): %v", text, err)
+		}
+	}
+	if err := serv.AppendDescription(1, "  "); err == nil {
+		t.Error("AppendDescription with blank text: expected error")
+	}
+
+	tasks, _ := repo.LoadTasks()
+	if got, want := mustTask(t, tasks, 1).Description, "task\nsecond line\nthird line"; got != want {
+		t.Errorf("Description = %q, want %q", got, want)
+	}
+}
+
+func TestRejectBlankDescription(t *testing.T) {
+	for _, desc := range []string{"", "   ", "\t \n"} {
+		repo := repository.NewMemoryTaskRepository(sampleTasks(1))
+		serv := NewTaskService(repo)
+
+		if _, err := serv.AddTask(desc, 0); err == nil {
+			t.Errorf("AddTask(%q): expected error", desc)
+		}
+		if err := serv.UpdateTask(1, desc); err == nil {
+			t.Errorf("UpdateTask(%q): expected error", desc)
+		}
+
+		tasks, _ := repo.LoadTasks()
+		if len(tasks) != 1 || tasks[0].Description != "task" {
+			t.Errorf("tasks changed after blank description %q: %+v", desc, tasks)
+		}
+	}
+}
+
+func TestImportTasksRejectsInvalid(t *testing.T) {
+	for _, desc := range []string{"", "   ", "\n\t"} {
+		repo := repository.NewMemoryTaskRepository(nil)
+		imported := []model.Task{
+			{Description: "ok", Status: model.StatusTodo},
+			{Description: desc, Status: model.StatusTodo},
+		}
+
+		if _, err := NewTaskService(repo).ImportTasks(imported); err == nil {
+			t.Errorf("ImportTasks(%q): expected error", desc)
+		}
+		if tasks, _ := repo.LoadTasks(); len(tasks) != 0 {
+			t.Errorf("ImportTasks(%q) saved %d tasks, want 0", desc, len(tasks))
+		}
+	}
+}
+
+func TestImportTasksNormalizesDescriptions(t *testing.T) {
+	repo := repository.NewMemoryTaskRepository(nil)
+	imported := []model.Task{
+		{Description: "  купить   молоко  ", Status: model.StatusTodo},
+		{Description: "позвонить", Status: model.StatusDone},
+	}
+
+	n, err := NewTaskService(repo).ImportTasks(imported)
+	if err != nil {
+		t.Fatalf("ImportTasks: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("ImportTasks = %d, want 2", n)
+	}
+
+	tasks, _ := repo.LoadTasks()
+	if got := mustTask(t, tasks, 1).Description; got != "купить молоко" {
+		t.Errorf("Description = %q, want %q", got, "купить молоко")
+	}
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"buy milk", "buy milk", false},
+		{"buy   milk", "buy milk", false},
+		{"  buy milk  ", "buy milk", false},
+		{"buy\tmilk", "buy milk", false},
+		{"\t buy \t\t milk \t", "buy milk", false},
+		{"line one  \n  line   two", "line one\nline two", false},
+		{"", "", true},
+		{" \t ", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeDescription(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("normalizeDescription(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeDescription(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAddAndUpdateNormalizeDescription(t *testing.T) {
+	repo := repository.NewMemoryTaskRepository(nil)
+	serv := NewTaskService(repo)
+
+	task, err := serv.AddTask("  buy \t  milk ", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Description != "buy milk" {
+		t.Errorf("AddTask description = %q, want %q", task.Description, "buy milk")
+	}
+
+	if err := serv.UpdateTask(task.Id, "buy\t\tbread  "); err != nil {
+		t.Fatal(err)
+	}
+	tasks, _ := repo.LoadTasks()
+	if got := mustTask(t, tasks, task.Id).Description; got != "buy bread" {
+		t.Errorf("UpdateTask description = %q, want %q", got, "buy bread")
+	}
+}
+
+func TestAddToEmptyFile(t *testing.T) {
+	for _, data := range []string{"", " \n\t\n"} {
+		file := filepath.Join(t.TempDir(), "tasks.json")
+		if err := os.WriteFile(file, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		serv := NewTaskService(repository.NewTaskRepository(file, false, func(error) {}))
+		task, err := serv.AddTask("first", 0)
+		if err != nil {
+			t.Fatalf("AddTask with file %q: %v", data, err)
+		}
+		if task.Id != 1 {
+			t.Errorf("AddTask with file %q: id = %d, want 1", data, task.Id)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"time"
+)
+
+func (s *taskService) StartTimer(id int) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return err
+	}
+
+	if runningEntry(task) != nil {
+		return fmt.Errorf(i18n.T("таймер задачи с ID %d уже запущен"), id)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	task.TimeEntries = append(task.TimeEntries, model.Interval{Start: now})
+	task.UpdatedAt = now
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) StopTimer(id int) (time.Duration, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return 0, err
+	}
+
+	entry := runningEntry(task)
+	if entry == nil {
+		return 0, fmt.Errorf(i18n.T("таймер задачи с ID %d не запущен"), id)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	stopTimer(task, now)
+	task.UpdatedAt = now
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return intervalDuration(*entry, time.Now()), nil
+}
+
+func (s *taskService) TrackedTime(id int, now time.Time) (time.Duration, bool, error) {
+	tasks, err := s.loadActiveTasks()
+	if err != nil {
+		return 0, false, err
+	}
+
+	task, err := taskById(tasks, id)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var total time.Duration
+	for _, entry := range task.TimeEntries {
+		total += intervalDuration(entry, now)
+	}
+
+	return total, runningEntry(task) != nil, nil
+}
+
+func runningEntry(task *model.Task) *model.Interval {
+	for i := range task.TimeEntries {
+		if task.TimeEntries[i].End == "" {
+			return &task.TimeEntries[i]
+		}
+	}
+
+	return nil
+}
+
+func stopTimer(task *model.Task, now string) {
+	if entry := runningEntry(task); entry != nil {
+		entry.End = now
+	}
+}
+
+func intervalDuration(entry model.Interval, now time.Time) time.Duration {
+	start, err := time.Parse(time.RFC3339, entry.Start)
+	if err != nil {
+		return 0
+	}
+
+	end := now
+	if entry.End != "" {
+		if end, err = time.Parse(time.RFC3339, entry.End); err != nil {
+			return 0
+		}
+	}
+
+	return max(end.Sub(start), 0)
+}
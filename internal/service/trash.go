@@ -0,0 +1,104 @@
+package service
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"go-task-cli/internal/model"
+	"slices"
+	"time"
+)
+
+func (s *taskService) TrashTasks() ([]model.Task, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	return filterTasks(tasks, func(task model.Task) bool {
+		return task.Deleted
+	}), nil
+}
+
+func (s *taskService) RestoreTask(id int) error {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	i := slices.IndexFunc(tasks, func(task model.Task) bool {
+		return task.Id == id && task.Deleted
+	})
+	if i == -1 {
+		return fmt.Errorf(i18n.T("задача с ID %d не найдена в корзине"), id)
+	}
+
+	tasks[i].Deleted = false
+	tasks[i].DeletedAt = ""
+	tasks[i].UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return nil
+}
+
+func (s *taskService) PurgeTrash(before time.Time) (int, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	remaining := slices.DeleteFunc(tasks, func(task model.Task) bool {
+		return task.Deleted && parseTimestamp(task.DeletedAt).Before(before)
+	})
+	purged := len(tasks) - len(remaining)
+	if purged == 0 {
+		return 0, nil
+	}
+
+	err = s.repo.SaveTasks(remaining)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return purged, nil
+}
+
+func (s *taskService) PurgeDone(before time.Time) (int, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	remaining := slices.DeleteFunc(tasks, func(task model.Task) bool {
+		finished := task.CompletedAt
+		if finished == "" {
+			finished = task.UpdatedAt
+		}
+		return task.Status == model.StatusDone && parseTimestamp(finished).Before(before)
+	})
+	purged := len(tasks) - len(remaining)
+	if purged == 0 {
+		return 0, nil
+	}
+
+	err = s.repo.SaveTasks(remaining)
+	if err != nil {
+		return 0, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return purged, nil
+}
+
+func (s *taskService) loadActiveTasks() ([]model.Task, error) {
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	return filterTasks(tasks, func(task model.Task) bool {
+		return !task.Deleted
+	}), nil
+}
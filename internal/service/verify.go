@@ -0,0 +1,35 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go-task-cli/internal/i18n"
+)
+
+type canonicalChecker interface {
+	IsCanonical() (bool, error)
+}
+
+func (s *taskService) VerifyFormat(rewrite bool) (bool, error) {
+	checker, ok := s.repo.(canonicalChecker)
+	if !ok {
+		return false, errors.New(i18n.T("проверка формата поддерживается только для хранилища json"))
+	}
+
+	canonical, err := checker.IsCanonical()
+	if err != nil || canonical || !rewrite {
+		return canonical, err
+	}
+
+	tasks, err := s.repo.LoadTasks()
+	if err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка загрузки задач: %w"), err)
+	}
+
+	err = s.repo.SaveTasks(tasks)
+	if err != nil {
+		return false, fmt.Errorf(i18n.T("ошибка записи файла задач: %w"), err)
+	}
+
+	return false, nil
+}
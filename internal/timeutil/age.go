@@ -0,0 +1,45 @@
+package timeutil
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"strconv"
+	"time"
+)
+
+var ageUnits = map[byte]time.Duration{
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+func ParseAge(value string) (time.Duration, error) {
+	if days, err := strconv.Atoi(value); err == nil && days >= 0 {
+		return time.Duration(days) * ageUnits['d'], nil
+	}
+
+	if n := len(value); n > 1 {
+		if unit, ok := ageUnits[value[n-1]]; ok {
+			if count, err := strconv.Atoi(value[:n-1]); err == nil && count >= 0 {
+				return time.Duration(count) * unit, nil
+			}
+		}
+	}
+
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return d, nil
+	}
+
+	return 0, fmt.Errorf(i18n.T("неверный срок %q (ожидается, например, 30, 30d, 2w или 12h)"), value)
+}
+
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if t, err := ParseDate(value); err == nil {
+		return t, nil
+	}
+
+	if age, err := ParseAge(value); err == nil {
+		return now.Add(-age), nil
+	}
+
+	return time.Time{}, fmt.Errorf(i18n.T("неверное начало периода %q (ожидается ГГГГ-ММ-ДД, RFC3339 или срок, например 7d)"), value)
+}
@@ -0,0 +1,61 @@
+package timeutil
+
+import (
+	"fmt"
+	"go-task-cli/internal/i18n"
+	"time"
+)
+
+const DateLayout = "2006-01-02"
+
+func ParseDate(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation(DateLayout, value, time.Local); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf(i18n.T("неверный формат даты %q (ожидается ГГГГ-ММ-ДД или RFC3339)"), value)
+}
+
+func DueDeadline(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation(DateLayout, value, time.Local); err == nil {
+		return t.AddDate(0, 0, 1), nil
+	}
+
+	return ParseDate(value)
+}
+
+func SameDay(a, b time.Time) bool {
+	ay, am, ad := a.In(time.Local).Date()
+	by, bm, bd := b.In(time.Local).Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func ShiftDue(due string, by time.Duration, now time.Time) (string, error) {
+	day := 24 * time.Hour
+	if due == "" {
+		if by%day == 0 {
+			now = now.In(time.Local)
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+			return today.AddDate(0, 0, int(by/day)).Format(DateLayout), nil
+		}
+		return now.Add(by).Format(time.RFC3339), nil
+	}
+
+	if t, err := time.ParseInLocation(DateLayout, due, time.Local); err == nil {
+		if by%day == 0 {
+			return t.AddDate(0, 0, int(by/day)).Format(DateLayout), nil
+		}
+		return t.AddDate(0, 0, 1).Add(by).Format(time.RFC3339), nil
+	}
+
+	t, err := ParseDate(due)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Add(by).Format(time.RFC3339), nil
+}
@@ -0,0 +1,72 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func useUTC(t *testing.T) {
+	t.Helper()
+	saved := time.Local
+	t.Cleanup(func() { time.Local = saved })
+	time.Local = time.UTC
+}
+
+func TestShiftDue(t *testing.T) {
+	useUTC(t)
+	now := time.Date(2026, 10, 14, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		due  string
+		by   time.Duration
+		want string
+	}{
+		{"no due, days", "", 48 * time.Hour, "2026-10-16"},
+		{"no due, hours", "", 3 * time.Hour, "2026-10-14T21:30:00Z"},
+		{"date, days", "2026-10-20", 7 * 24 * time.Hour, "2026-10-27"},
+		{"date, hours from end of day", "2026-10-20", 3 * time.Hour, "2026-10-21T03:00:00Z"},
+		{"timestamp", "2026-10-20T10:00:00+03:00", 90 * time.Minute, "2026-10-20T11:30:00+03:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ShiftDue(tt.due, tt.by, now)
+			if err != nil {
+				t.Fatalf("ShiftDue(%q, %v): %v", tt.due, tt.by, err)
+			}
+			if got != tt.want {
+				t.Errorf("ShiftDue(%q, %v) = %q, want %q", tt.due, tt.by, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftDueNeverEarlier(t *testing.T) {
+	useUTC(t)
+	now := time.Date(2026, 10, 14, 18, 30, 0, 0, time.UTC)
+
+	for _, due := range []string{"2026-10-20", "2026-10-20T10:00:00Z"} {
+		before, err := DueDeadline(due)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shifted, err := ShiftDue(due, time.Hour, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		after, err := DueDeadline(shifted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !after.After(before) {
+			t.Errorf("ShiftDue(%q, 1h) = %q: deadline %v is not after %v", due, shifted, after, before)
+		}
+	}
+}
+
+func TestShiftDueInvalid(t *testing.T) {
+	if _, err := ShiftDue("someday", time.Hour, time.Now()); err == nil {
+		t.Error("expected error for invalid due date")
+	}
+}
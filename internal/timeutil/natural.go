@@ -0,0 +1,67 @@
+package timeutil
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func ResolveDate(value string, now time.Time) (string, error) {
+	if day, ok := parseNatural(value, now); ok {
+		return day.Format(DateLayout), nil
+	}
+
+	if _, err := ParseDate(value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+func parseNatural(value string, now time.Time) (time.Time, bool) {
+	phrase := strings.Join(strings.Fields(strings.ToLower(value)), " ")
+	now = now.In(time.Local)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	switch phrase {
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+
+	if name, ok := strings.CutPrefix(phrase, "next "); ok {
+		weekday, ok := weekdays[name]
+		if !ok {
+			return time.Time{}, false
+		}
+
+		days := (int(weekday)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days), true
+	}
+
+	if offset, ok := strings.CutPrefix(phrase, "+"); ok {
+		digits, ok := strings.CutSuffix(offset, "d")
+		if !ok {
+			return time.Time{}, false
+		}
+
+		days, err := strconv.Atoi(digits)
+		if err != nil || days < 0 {
+			return time.Time{}, false
+		}
+		return today.AddDate(0, 0, days), true
+	}
+
+	return time.Time{}, false
+}